@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -10,22 +11,108 @@ import (
 type AvailabilityClient struct {
 	transport Transport
 	config    *Config
+
+	// Deadline management for a running pollUntilComplete, following the
+	// deadlineTimer pattern from the netstack gonet adapter: a mutex-guarded
+	// timer/cancel-channel pair per deadline, so SetSearchDeadline and
+	// SetPollDeadline can be called concurrently with an in-flight search.
+	mu             sync.Mutex
+	searchTimer    *time.Timer
+	searchCancelCh chan struct{}
+	pollTimer      *time.Timer
+	pollCancelCh   chan struct{}
 }
 
 // NewAvailabilityClient creates a new AvailabilityClient
 func NewAvailabilityClient(transport Transport, config *Config) *AvailabilityClient {
 	return &AvailabilityClient{
-		transport: transport,
-		config:    config,
+		transport:      transport,
+		config:         config,
+		searchCancelCh: make(chan struct{}),
+		pollCancelCh:   make(chan struct{}),
 	}
 }
 
+// SetSearchDeadline sets the absolute time at which the overall search (the
+// whole pollUntilComplete loop, across every poll round) is abandoned. A
+// zero Time clears the deadline. Safe to call concurrently with a running
+// Search, letting a long-running agent shorten or extend the availability
+// SLA mid-flight without cancelling and re-issuing the search.
+func (ac *AvailabilityClient) SetSearchDeadline(t time.Time) {
+	ac.setDeadline(&ac.searchTimer, &ac.searchCancelCh, t)
+}
+
+// SetPollDeadline sets the absolute time at which the single in-flight
+// long-poll round trip is abandoned. A zero Time clears the deadline (the
+// default - individual poll rounds are bounded only by longPollWaitMs).
+func (ac *AvailabilityClient) SetPollDeadline(t time.Time) {
+	ac.setDeadline(&ac.pollTimer, &ac.pollCancelCh, t)
+}
+
+// setDeadline arms or clears one of the timer/cancel-channel pairs. If timer
+// is already running, it's stopped first; if Stop reports the timer already
+// fired (its cancel channel is therefore already closed), a fresh channel is
+// installed so future waiters see an open channel again. The same happens
+// when no timer is armed but the channel was closed directly by an earlier
+// deadline that was already in the past.
+func (ac *AvailabilityClient) setDeadline(timer **time.Timer, cancelCh *chan struct{}, t time.Time) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if *timer != nil && !(*timer).Stop() {
+		*cancelCh = make(chan struct{})
+	} else if *timer == nil {
+		select {
+		case <-*cancelCh:
+			*cancelCh = make(chan struct{})
+		default:
+		}
+	}
+
+	if t.IsZero() {
+		*timer = nil
+		return
+	}
+
+	remaining := time.Until(t)
+	if remaining <= 0 {
+		close(*cancelCh)
+		return
+	}
+
+	ch := *cancelCh
+	*timer = time.AfterFunc(remaining, func() {
+		close(ch)
+	})
+}
+
+// searchCancel returns the cancel channel currently backing SetSearchDeadline.
+func (ac *AvailabilityClient) searchCancel() <-chan struct{} {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	return ac.searchCancelCh
+}
+
+// pollCancel returns the cancel channel currently backing SetPollDeadline.
+func (ac *AvailabilityClient) pollCancel() <-chan struct{} {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	return ac.pollCancelCh
+}
+
 // SearchResult represents a single chunk from the search stream
 type SearchResult struct {
 	Chunk *AvailabilityChunk
 	Error error
 }
 
+// streamErrorKey is the reserved map key RestTransport.StreamAvailability and
+// GrpcTransport.StreamAvailability use to carry a terminal stream error
+// through their <-chan map[string]interface{} before closing it, since that
+// channel shape has no dedicated error field. streamUntilComplete treats a
+// chunk carrying this key as the stream breaking, not as a clean finish.
+const streamErrorKey = "_stream_error"
+
 // Search performs an availability search and returns results via a channel
 // This follows Go idioms for async iteration using channels
 func (ac *AvailabilityClient) Search(ctx context.Context, criteria *AvailabilityCriteria) (<-chan *SearchResult, error) {
@@ -55,55 +142,117 @@ func (ac *AvailabilityClient) Search(ctx context.Context, criteria *Availability
 	// Create channel for results
 	resultChan := make(chan *SearchResult, 1)
 
-	// Start polling in goroutine
-	go ac.pollUntilComplete(ctx, requestID, resultChan)
+	mode := ac.config.GetString("streamMode", "auto")
+	go ac.run(ctx, requestID, mode, resultChan)
 
 	return resultChan, nil
 }
 
-// pollUntilComplete polls for results until complete or deadline
-func (ac *AvailabilityClient) pollUntilComplete(ctx context.Context, requestID string, resultChan chan<- *SearchResult) {
+// run dispatches to the streaming or long-poll code path according to mode,
+// always delivering chunks on resultChan in the same shape regardless of
+// which transport carried them.
+func (ac *AvailabilityClient) run(ctx context.Context, requestID, mode string, resultChan chan<- *SearchResult) {
 	defer close(resultChan)
 
-	since := 0
-	slaMs := ac.config.GetInt("availabilitySlaMs", 120000)
-	deadline := time.Now().Add(time.Duration(slaMs) * time.Millisecond)
+	switch mode {
+	case "poll":
+		ac.pollUntilComplete(ctx, requestID, resultChan)
+	case "sse", "grpc-stream":
+		ac.streamUntilComplete(ctx, requestID, resultChan)
+	default: // "auto"
+		if !ac.streamUntilComplete(ctx, requestID, resultChan) {
+			ac.pollUntilComplete(ctx, requestID, resultChan)
+		}
+	}
+}
 
-	for {
-		// Check deadline
-		remaining := time.Until(deadline)
-		if remaining <= 0 {
-			return
+// streamUntilComplete consumes the streaming transport until the chunk
+// stream reports COMPLETE, an error occurs, or streaming is unsupported by
+// the backend. It returns false only when streaming is unsupported (404/406
+// from the REST stream endpoint), signaling the caller should fall back to
+// long-poll; any other outcome - including a mid-stream error - is
+// considered handled.
+func (ac *AvailabilityClient) streamUntilComplete(ctx context.Context, requestID string, resultChan chan<- *SearchResult) bool {
+	chunks, err := ac.transport.StreamAvailability(ctx, requestID)
+	if err != nil {
+		if err == ErrStreamUnsupported {
+			return false
 		}
+		resultChan <- &SearchResult{Error: err}
+		return true
+	}
 
-		// Calculate wait time
-		longPollWaitMs := ac.config.GetInt("longPollWaitMs", 10000)
-		waitMs := int(remaining.Milliseconds())
-		if waitMs > longPollWaitMs {
-			waitMs = longPollWaitMs
+	for raw := range chunks {
+		if msg, ok := raw[streamErrorKey].(string); ok {
+			resultChan <- &SearchResult{Error: fmt.Errorf("availability stream broke: %s", msg)}
+			return true
 		}
 
-		// Poll for results
-		res, err := ac.transport.AvailabilityPoll(ctx, requestID, since, waitMs)
-		if err != nil {
-			resultChan <- &SearchResult{Error: err}
-			return
+		chunk := AvailabilityChunkFromMap(raw)
+		resultChan <- &SearchResult{Chunk: chunk}
+		if chunk.Status == "COMPLETE" {
+			return true
 		}
+	}
 
-		// Parse chunk
-		chunk := AvailabilityChunkFromMap(res)
+	return true
+}
 
-		// Update cursor
-		if chunk.Cursor != nil {
-			since = *chunk.Cursor
-		}
+// pollOutcome is the result of one AvailabilityPoll round trip, delivered
+// over a channel so pollUntilComplete can select on it alongside ctx.Done()
+// and the deadline cancel channels.
+type pollOutcome struct {
+	chunk *AvailabilityChunk
+	err   error
+}
 
-		// Send chunk
-		resultChan <- &SearchResult{Chunk: chunk}
+// pollUntilComplete polls for results until complete, the context is
+// cancelled, or the search/poll deadline (SetSearchDeadline/SetPollDeadline)
+// fires. Starts with a fresh search deadline derived from availabilitySlaMs;
+// callers may narrow or widen it mid-flight via SetSearchDeadline.
+func (ac *AvailabilityClient) pollUntilComplete(ctx context.Context, requestID string, resultChan chan<- *SearchResult) {
+	slaMs := ac.config.GetInt("availabilitySlaMs", 120000)
+	ac.SetSearchDeadline(time.Now().Add(time.Duration(slaMs) * time.Millisecond))
+	defer ac.SetSearchDeadline(time.Time{})
+	defer ac.SetPollDeadline(time.Time{})
 
-		// Check if complete
-		if chunk.Status == "COMPLETE" {
+	since := 0
+	longPollWaitMs := ac.config.GetInt("longPollWaitMs", 10000)
+
+	for {
+		outcome := make(chan pollOutcome, 1)
+		go func(since int) {
+			res, err := ac.transport.AvailabilityPoll(ctx, requestID, since, longPollWaitMs)
+			if err != nil {
+				outcome <- pollOutcome{err: err}
+				return
+			}
+			outcome <- pollOutcome{chunk: AvailabilityChunkFromMap(res)}
+		}(since)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ac.searchCancel():
+			return
+		case <-ac.pollCancel():
+			resultChan <- &SearchResult{Error: fmt.Errorf("availability poll deadline exceeded")}
 			return
+		case o := <-outcome:
+			if o.err != nil {
+				resultChan <- &SearchResult{Error: o.err}
+				return
+			}
+
+			if o.chunk.Cursor != nil {
+				since = *o.chunk.Cursor
+			}
+
+			resultChan <- &SearchResult{Chunk: o.chunk}
+
+			if o.chunk.Status == "COMPLETE" {
+				return
+			}
 		}
 	}
 }
@@ -111,3 +111,114 @@ func (bc *BookingClient) Check(ctx context.Context, supplierBookingRef, agreemen
 	return BookingResultFromMap(result), nil
 }
 
+// GetStatus is a thin alias over Check that surfaces the typed BookingStatus
+// enum directly, for callers that only care about lifecycle state.
+func (bc *BookingClient) GetStatus(ctx context.Context, supplierBookingRef, agreementRef string) (BookingStatus, error) {
+	result, err := bc.Check(ctx, supplierBookingRef, agreementRef, "")
+	if err != nil {
+		return "", err
+	}
+	return result.StatusCode, nil
+}
+
+// Update modifies an existing booking using a field mask so callers can
+// change e.g. driver details or pickup/dropoff time without resending the
+// whole record. fieldMask lists the top-level keys in patch that should be
+// applied; keys not listed are ignored even if present in patch.
+func (bc *BookingClient) Update(ctx context.Context, supplierBookingRef string, agreementRef string, patch map[string]interface{}, fieldMask []string) (*BookingResult, error) {
+	if supplierBookingRef == "" {
+		return nil, fmt.Errorf("supplier_booking_ref required")
+	}
+	if agreementRef == "" {
+		return nil, fmt.Errorf("agreement_ref required")
+	}
+	if len(fieldMask) == 0 {
+		return nil, fmt.Errorf("field_mask required")
+	}
+
+	fields := make(map[string]interface{}, len(fieldMask))
+	for _, key := range fieldMask {
+		if v, ok := patch[key]; ok {
+			fields[key] = v
+		}
+	}
+
+	payload := map[string]interface{}{
+		"supplier_booking_ref": supplierBookingRef,
+		"agreement_ref":        agreementRef,
+		"fields":               fields,
+		"field_mask":           fieldMask,
+	}
+
+	result, err := bc.transport.BookingModify(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return BookingResultFromMap(result), nil
+}
+
+// CancelWithReason cancels a booking and passes reason along in the cancel
+// payload, for suppliers/analytics that track why a booking was dropped.
+// GrpcTransport and GrpcWebTransport send the reason; RestTransport's
+// BookingCancel sends no request body, so over REST the reason is dropped.
+func (bc *BookingClient) CancelWithReason(ctx context.Context, supplierBookingRef, agreementRef, reason string) (*BookingResult, error) {
+	if supplierBookingRef == "" {
+		return nil, fmt.Errorf("supplier_booking_ref required")
+	}
+	if agreementRef == "" {
+		return nil, fmt.Errorf("agreement_ref required")
+	}
+
+	payload := map[string]interface{}{
+		"supplier_booking_ref": supplierBookingRef,
+		"agreement_ref":        agreementRef,
+	}
+	if reason != "" {
+		payload["reason"] = reason
+	}
+
+	result, err := bc.transport.BookingCancel(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return BookingResultFromMap(result), nil
+}
+
+// BookingListResult is a single page delivered by BookingClient.List.
+type BookingListResult struct {
+	Page  *BookingListPage
+	Error error
+}
+
+// List iterates bookings matching filter, yielding pages on a channel in the
+// same style as AvailabilityClient.Search, and following cursors until the
+// backend reports no further pages.
+func (bc *BookingClient) List(ctx context.Context, filter ListBookingsFilter) (<-chan *BookingListResult, error) {
+	resultChan := make(chan *BookingListResult, 1)
+	go bc.listPages(ctx, filter, resultChan)
+	return resultChan, nil
+}
+
+func (bc *BookingClient) listPages(ctx context.Context, filter ListBookingsFilter, resultChan chan<- *BookingListResult) {
+	defer close(resultChan)
+
+	for {
+		result, err := bc.transport.ListBookings(ctx, filter.ToMap())
+		if err != nil {
+			resultChan <- &BookingListResult{Error: err}
+			return
+		}
+
+		page := BookingListPageFromMap(result)
+		resultChan <- &BookingListResult{Page: page}
+
+		if page.Cursor == nil {
+			return
+		}
+
+		filter.Cursor = *page.Cursor
+	}
+}
+
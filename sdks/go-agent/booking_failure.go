@@ -0,0 +1,78 @@
+package sdk
+
+import "fmt"
+
+// BookingFailureCause is a stable, machine-readable reason a booking
+// operation did not succeed. Callers should branch on Cause rather than
+// scraping error text to drive retry/UI logic.
+type BookingFailureCause string
+
+const (
+	BookingFailurePaymentCardDeclined     BookingFailureCause = "PAYMENT_ERROR_CARD_DECLINED"
+	BookingFailurePaymentCardTypeRejected BookingFailureCause = "PAYMENT_ERROR_CARD_TYPE_REJECTED"
+	BookingFailureUserCannotBeIdentified  BookingFailureCause = "USER_CANNOT_BE_IDENTIFIED"
+	BookingFailureSlotUnavailable         BookingFailureCause = "SLOT_UNAVAILABLE"
+	BookingFailureLeaseExpired            BookingFailureCause = "LEASE_EXPIRED"
+	BookingFailureRatePlanUnavailable     BookingFailureCause = "RATE_PLAN_UNAVAILABLE"
+	BookingFailureDriverAgeNotAllowed     BookingFailureCause = "DRIVER_AGE_NOT_ALLOWED"
+	BookingFailureInvalidUnlocode         BookingFailureCause = "INVALID_UNLOCODE"
+	BookingFailureInternalError           BookingFailureCause = "INTERNAL_ERROR"
+	BookingFailureOther                   BookingFailureCause = "OTHER"
+)
+
+// BookingFailure is a structured error describing why a booking operation
+// (create/lease/modify/cancel) was rejected, modeled after the reason codes
+// used by partner booking APIs.
+type BookingFailure struct {
+	Cause            BookingFailureCause
+	Description      string
+	RejectedCardType string
+	Retryable        bool
+}
+
+// Error implements the error interface.
+func (bf *BookingFailure) Error() string {
+	if bf.Description != "" {
+		return fmt.Sprintf("BookingFailure: %s (%s)", bf.Cause, bf.Description)
+	}
+	return fmt.Sprintf("BookingFailure: %s", bf.Cause)
+}
+
+// NewBookingFailure creates a BookingFailure with the given cause and
+// description, defaulting Retryable to false.
+func NewBookingFailure(cause BookingFailureCause, description string) *BookingFailure {
+	return &BookingFailure{
+		Cause:       cause,
+		Description: description,
+	}
+}
+
+// BookingFailureFromMap parses a backend error payload shaped like
+// {"cause": "...", "description": "...", "rejected_card_type": "...", "retryable": bool}
+// into a BookingFailure. Returns nil if data doesn't look like a booking
+// failure payload (no "cause" field).
+func BookingFailureFromMap(data map[string]interface{}) *BookingFailure {
+	if data == nil {
+		return nil
+	}
+
+	causeRaw, ok := data["cause"].(string)
+	if !ok || causeRaw == "" {
+		return nil
+	}
+
+	bf := &BookingFailure{
+		Cause: BookingFailureCause(causeRaw),
+	}
+	if description, ok := data["description"].(string); ok {
+		bf.Description = description
+	}
+	if rejectedCardType, ok := data["rejected_card_type"].(string); ok {
+		bf.RejectedCardType = rejectedCardType
+	}
+	if retryable, ok := data["retryable"].(bool); ok {
+		bf.Retryable = retryable
+	}
+
+	return bf
+}
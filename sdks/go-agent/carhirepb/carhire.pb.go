@@ -0,0 +1,316 @@
+// Hand-written stand-in for protoc-gen-go output; these types do not
+// implement proto.Message.
+// source: carhire.proto
+
+package carhirepb
+
+// AvailabilitySubmitRequest is the typed subset of AvailabilityCriteria.ToMap()
+// that's worth routing/logging on; the rest travels as CriteriaJson.
+type AvailabilitySubmitRequest struct {
+	AgreementRefs []string `protobuf:"bytes,1,rep,name=agreement_refs,json=agreementRefs,proto3" json:"agreement_refs,omitempty"`
+	PickupLocode  string   `protobuf:"bytes,2,opt,name=pickup_locode,json=pickupLocode,proto3" json:"pickup_locode,omitempty"`
+	DropoffLocode string   `protobuf:"bytes,3,opt,name=dropoff_locode,json=dropoffLocode,proto3" json:"dropoff_locode,omitempty"`
+	PickupTime    string   `protobuf:"bytes,4,opt,name=pickup_time,json=pickupTime,proto3" json:"pickup_time,omitempty"`
+	DropoffTime   string   `protobuf:"bytes,5,opt,name=dropoff_time,json=dropoffTime,proto3" json:"dropoff_time,omitempty"`
+	CriteriaJson  []byte   `protobuf:"bytes,15,opt,name=criteria_json,json=criteriaJson,proto3" json:"criteria_json,omitempty"`
+}
+
+func (x *AvailabilitySubmitRequest) GetAgreementRefs() []string {
+	if x != nil {
+		return x.AgreementRefs
+	}
+	return nil
+}
+
+func (x *AvailabilitySubmitRequest) GetCriteriaJson() []byte {
+	if x != nil {
+		return x.CriteriaJson
+	}
+	return nil
+}
+
+// AvailabilitySubmitResponse mirrors the REST /availability/submit response.
+type AvailabilitySubmitResponse struct {
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *AvailabilitySubmitResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// AvailabilityPollRequest mirrors the REST /availability/poll query params.
+type AvailabilityPollRequest struct {
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SinceSeq  int32  `protobuf:"varint,2,opt,name=since_seq,json=sinceSeq,proto3" json:"since_seq,omitempty"`
+	WaitMs    int32  `protobuf:"varint,3,opt,name=wait_ms,json=waitMs,proto3" json:"wait_ms,omitempty"`
+}
+
+func (x *AvailabilityPollRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AvailabilityPollRequest) GetSinceSeq() int32 {
+	if x != nil {
+		return x.SinceSeq
+	}
+	return 0
+}
+
+func (x *AvailabilityPollRequest) GetWaitMs() int32 {
+	if x != nil {
+		return x.WaitMs
+	}
+	return 0
+}
+
+// AvailabilityChunkResponse is the typed subset of AvailabilityChunkFromMap's
+// input; OffersJson carries the offer list dto.go already knows how to parse.
+type AvailabilityChunkResponse struct {
+	Status     string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Cursor     int32  `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	OffersJson []byte `protobuf:"bytes,15,opt,name=offers_json,json=offersJson,proto3" json:"offers_json,omitempty"`
+}
+
+func (x *AvailabilityChunkResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AvailabilityChunkResponse) GetCursor() int32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *AvailabilityChunkResponse) GetOffersJson() []byte {
+	if x != nil {
+		return x.OffersJson
+	}
+	return nil
+}
+
+// AvailabilityStreamRequest mirrors AvailabilityPollRequest but drops wait_ms
+// - the stream pushes as results arrive instead of being polled for them.
+type AvailabilityStreamRequest struct {
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	SinceSeq  int32  `protobuf:"varint,2,opt,name=since_seq,json=sinceSeq,proto3" json:"since_seq,omitempty"`
+}
+
+func (x *AvailabilityStreamRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AvailabilityStreamRequest) GetSinceSeq() int32 {
+	if x != nil {
+		return x.SinceSeq
+	}
+	return 0
+}
+
+// AvailabilityEvent is one message pushed by the AvailabilityStream RPC; same
+// shape as AvailabilityChunkResponse.
+type AvailabilityEvent struct {
+	Status     string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Cursor     int32  `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	OffersJson []byte `protobuf:"bytes,15,opt,name=offers_json,json=offersJson,proto3" json:"offers_json,omitempty"`
+}
+
+func (x *AvailabilityEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AvailabilityEvent) GetCursor() int32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *AvailabilityEvent) GetOffersJson() []byte {
+	if x != nil {
+		return x.OffersJson
+	}
+	return nil
+}
+
+// ResolveAgreementRequest mirrors RestTransport.ResolveAgreement's query.
+type ResolveAgreementRequest struct {
+	AgreementRef string `protobuf:"bytes,1,opt,name=agreement_ref,json=agreementRef,proto3" json:"agreement_ref,omitempty"`
+}
+
+func (x *ResolveAgreementRequest) GetAgreementRef() string {
+	if x != nil {
+		return x.AgreementRef
+	}
+	return ""
+}
+
+// ResolveAgreementResponse carries the backend's internal agreement ID.
+type ResolveAgreementResponse struct {
+	AgreementId string `protobuf:"bytes,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty"`
+}
+
+func (x *ResolveAgreementResponse) GetAgreementId() string {
+	if x != nil {
+		return x.AgreementId
+	}
+	return ""
+}
+
+// AgreementCoverageRequest mirrors RestTransport.AgreementCoverage's path.
+type AgreementCoverageRequest struct {
+	AgreementId string `protobuf:"bytes,1,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty"`
+}
+
+func (x *AgreementCoverageRequest) GetAgreementId() string {
+	if x != nil {
+		return x.AgreementId
+	}
+	return ""
+}
+
+// AgreementCoverageResponse is the LOCODE list AgreementResolver caches.
+type AgreementCoverageResponse struct {
+	Locodes []string `protobuf:"bytes,1,rep,name=locodes,proto3" json:"locodes,omitempty"`
+}
+
+func (x *AgreementCoverageResponse) GetLocodes() []string {
+	if x != nil {
+		return x.Locodes
+	}
+	return nil
+}
+
+// BookingCreateRequest mirrors RestTransport.BookingCreate; PayloadJson
+// carries the BookingCreate.ToMap() payload.
+type BookingCreateRequest struct {
+	IdempotencyKey string `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	PayloadJson    []byte `protobuf:"bytes,15,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+}
+
+func (x *BookingCreateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *BookingCreateRequest) GetPayloadJson() []byte {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return nil
+}
+
+// BookingModifyRequest mirrors RestTransport.BookingModify.
+type BookingModifyRequest struct {
+	SupplierBookingRef string `protobuf:"bytes,1,opt,name=supplier_booking_ref,json=supplierBookingRef,proto3" json:"supplier_booking_ref,omitempty"`
+	AgreementRef       string `protobuf:"bytes,2,opt,name=agreement_ref,json=agreementRef,proto3" json:"agreement_ref,omitempty"`
+	FieldsJson         []byte `protobuf:"bytes,15,opt,name=fields_json,json=fieldsJson,proto3" json:"fields_json,omitempty"`
+}
+
+func (x *BookingModifyRequest) GetSupplierBookingRef() string {
+	if x != nil {
+		return x.SupplierBookingRef
+	}
+	return ""
+}
+
+func (x *BookingModifyRequest) GetAgreementRef() string {
+	if x != nil {
+		return x.AgreementRef
+	}
+	return ""
+}
+
+func (x *BookingModifyRequest) GetFieldsJson() []byte {
+	if x != nil {
+		return x.FieldsJson
+	}
+	return nil
+}
+
+// BookingCancelRequest mirrors RestTransport.BookingCancel.
+type BookingCancelRequest struct {
+	SupplierBookingRef string `protobuf:"bytes,1,opt,name=supplier_booking_ref,json=supplierBookingRef,proto3" json:"supplier_booking_ref,omitempty"`
+	AgreementRef       string `protobuf:"bytes,2,opt,name=agreement_ref,json=agreementRef,proto3" json:"agreement_ref,omitempty"`
+	Reason             string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *BookingCancelRequest) GetSupplierBookingRef() string {
+	if x != nil {
+		return x.SupplierBookingRef
+	}
+	return ""
+}
+
+func (x *BookingCancelRequest) GetAgreementRef() string {
+	if x != nil {
+		return x.AgreementRef
+	}
+	return ""
+}
+
+func (x *BookingCancelRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BookingCheckRequest mirrors RestTransport.BookingCheck.
+type BookingCheckRequest struct {
+	SupplierBookingRef string `protobuf:"bytes,1,opt,name=supplier_booking_ref,json=supplierBookingRef,proto3" json:"supplier_booking_ref,omitempty"`
+	AgreementRef       string `protobuf:"bytes,2,opt,name=agreement_ref,json=agreementRef,proto3" json:"agreement_ref,omitempty"`
+	SourceId           string `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+}
+
+func (x *BookingCheckRequest) GetSupplierBookingRef() string {
+	if x != nil {
+		return x.SupplierBookingRef
+	}
+	return ""
+}
+
+func (x *BookingCheckRequest) GetAgreementRef() string {
+	if x != nil {
+		return x.AgreementRef
+	}
+	return ""
+}
+
+func (x *BookingCheckRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+// BookingResultResponse wraps a BookingResultFromMap payload for every
+// booking RPC (create/modify/cancel/check share one response shape, same as
+// BookingResult on the REST side).
+type BookingResultResponse struct {
+	ResultJson []byte `protobuf:"bytes,15,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
+}
+
+func (x *BookingResultResponse) GetResultJson() []byte {
+	if x != nil {
+		return x.ResultJson
+	}
+	return nil
+}
@@ -2,69 +2,152 @@ package sdk
 
 import (
 	"context"
-	"net/http"
+	"fmt"
+	"sync"
 )
 
-// Config holds SDK configuration
-type Config struct {
-	// REST Configuration
-	BaseURL string
-	Token   string
-	APIKey  string
-	
-	// gRPC Configuration
-	Host       string
-	CACert     string
-	ClientCert string
-	ClientKey  string
-	
-	// Common
-	AgentID           string
-	CallTimeoutMs     int
-	AvailabilitySlaMs int
-	LongPollWaitMs    int
-	CorrelationID     string
-}
-
 // Client is the main SDK client
 type Client struct {
-	config     Config
-	httpClient *http.Client
-	transport  Transport
+	config    *Config
+	transport Transport
+
+	resolverOnce sync.Once
+	resolver     *AgreementResolver
 }
 
-// NewClient creates a new SDK client with REST transport
-func NewClient(config Config) *Client {
-	return &Client{
-		config:     config,
-		httpClient: &http.Client{},
-		transport:  NewRestTransport(config),
+// TransportFactory builds a Transport from Config, failing if the config is
+// missing something that transport needs (e.g. grpc requires config.Host).
+type TransportFactory func(*Config) (Transport, error)
+
+var (
+	transportRegistryMu sync.RWMutex
+	transportRegistry   = map[string]TransportFactory{}
+)
+
+func init() {
+	RegisterTransport("http", func(config *Config) (Transport, error) {
+		return NewRestTransport(config), nil
+	})
+	RegisterTransport("grpc", func(config *Config) (Transport, error) {
+		return NewGrpcTransport(config)
+	})
+	RegisterTransport("grpc-web", func(config *Config) (Transport, error) {
+		return NewGrpcWebTransport(config), nil
+	})
+}
+
+// RegisterTransport makes a Transport implementation available under name
+// for NewClient/NewTransport to resolve, the way go-micro's transport
+// package lets callers plug in alternatives (in-memory, NATS, ...) without
+// forking the SDK. Registering under an existing name replaces it.
+func RegisterTransport(name string, factory TransportFactory) {
+	transportRegistryMu.Lock()
+	defer transportRegistryMu.Unlock()
+	transportRegistry[name] = factory
+}
+
+// NewTransport builds the Transport registered under name. Built in: "http"
+// (RestTransport), "grpc" (GrpcTransport) and "grpc-web" (GrpcWebTransport).
+func NewTransport(name string, config *Config) (Transport, error) {
+	transportRegistryMu.RLock()
+	factory, ok := transportRegistry[name]
+	transportRegistryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no transport registered under %q", name)
+	}
+	return factory(config)
+}
+
+// NewClient creates a new SDK client, resolving config.TransportName (via
+// NewTransport) into the concrete Transport it talks through. Defaults to
+// "http" when TransportName is unset.
+func NewClient(config *Config) (*Client, error) {
+	name := config.GetString("transportName", "http")
+	transport, err := NewTransport(name, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client: %w", err)
 	}
+
+	return &Client{
+		config:    config,
+		transport: transport,
+	}, nil
 }
 
 // Availability returns the availability client
 func (c *Client) Availability() *AvailabilityClient {
-	return NewAvailabilityClient(c.transport)
+	return NewAvailabilityClient(c.transport, c.config)
 }
 
 // Booking returns the booking client
 func (c *Client) Booking() *BookingClient {
-	return NewBookingClient(c.transport)
+	return NewBookingClient(c.transport, c.config)
 }
 
-// Locations returns the locations client
+// Locations returns the locations client. Each call gets a fresh
+// *LocationsClient, but every one shares the same *AgreementResolver (built
+// once, lazily) so its coverage cache survives across calls instead of being
+// thrown away with the LocationsClient that warmed it.
 func (c *Client) Locations() *LocationsClient {
-	return NewLocationsClient(c.transport)
+	return NewLocationsClientWithResolver(c.transport, c.config, c.agreementResolver())
+}
+
+// agreementResolver returns the Client's shared AgreementResolver, building
+// it on first use.
+func (c *Client) agreementResolver() *AgreementResolver {
+	c.resolverOnce.Do(func() {
+		c.resolver = NewAgreementResolver(c.transport, c.config)
+	})
+	return c.resolver
+}
+
+// Close releases any resources held by the underlying Transport (e.g.
+// GrpcTransport's *grpc.ClientConn). Transport itself has no Close method -
+// most transports (RestTransport, GrpcWebTransport) are plain HTTP clients
+// with nothing to tear down - so this checks for the optional io.Closer-style
+// interface instead, the same pattern net/http and friends use for
+// best-effort cleanup. A no-op for transports that don't need it.
+func (c *Client) Close() error {
+	if closer, ok := c.transport.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Lease returns the lease client, for holding an offer's inventory/price
+// ahead of a single-shot BookingCreate.
+//
+// NOTE: Lease and Reservation cover largely the same "hold before booking"
+// workflow and currently coexist rather than sharing one concept - whether
+// they should merge needs sign-off from whoever requested both before either
+// ships to SDK consumers.
+func (c *Client) Lease() *LeaseClient {
+	return NewLeaseClient(c.transport, c.config)
+}
+
+// Reservation returns the reservation client, for the hold/confirm flow that
+// lets a caller collect driver details before turning a held offer into a
+// booking. See the note on Lease about its overlap with this client.
+func (c *Client) Reservation() *ReservationClient {
+	return NewReservationClient(c.transport, c.config)
 }
 
 // Transport interface for REST and gRPC
 type Transport interface {
-	SubmitAvailability(ctx context.Context, criteria AvailabilityCriteria) (string, error)
-	PollAvailability(ctx context.Context, requestID string, sinceSeq int, waitMs int) (*AvailabilityChunk, error)
-	CreateBooking(ctx context.Context, booking BookingCreate, idempotencyKey string) (*BookingResult, error)
-	ModifyBooking(ctx context.Context, bookingRef string, agreementRef string, idempotencyKey string) (*BookingResult, error)
-	CancelBooking(ctx context.Context, bookingRef string, agreementRef string, idempotencyKey string) (*BookingResult, error)
-	CheckBooking(ctx context.Context, bookingRef string, agreementRef string) (*BookingResult, error)
-	GetLocations(ctx context.Context) ([]Location, error)
+	AvailabilitySubmit(ctx context.Context, criteria map[string]interface{}) (map[string]interface{}, error)
+	AvailabilityPoll(ctx context.Context, requestID string, sinceSeq int, waitMs int) (map[string]interface{}, error)
+	StreamAvailability(ctx context.Context, requestID string) (<-chan map[string]interface{}, error)
+	ResolveAgreement(ctx context.Context, agreementRef string) (string, error)
+	AgreementCoverage(ctx context.Context, agreementID string) ([]string, error)
+	BookingCreate(ctx context.Context, payload map[string]interface{}, idempotencyKey string) (map[string]interface{}, error)
+	BookingModify(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error)
+	BookingCancel(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error)
+	BookingCheck(ctx context.Context, supplierBookingRef, agreementRef, sourceID string) (map[string]interface{}, error)
+	ListBookings(ctx context.Context, filter map[string]interface{}) (map[string]interface{}, error)
+	LeaseCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error)
+	LeaseRelease(ctx context.Context, payload map[string]interface{}) error
+	ReservationCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error)
+	ReservationExtend(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error)
+	ReservationRelease(ctx context.Context, reservationRef string) error
+	ReservationConfirm(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error)
 }
-
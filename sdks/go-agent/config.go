@@ -3,7 +3,11 @@ package sdk
 import (
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
 )
 
 // ConfigData holds raw configuration data
@@ -21,12 +25,39 @@ type ConfigData struct {
 	CACert     string
 	ClientCert string
 	ClientKey  string
+
+	// RestTransport middleware (idempotency cache, retry, circuit breaker)
+	IdempotencyTtlMs         int
+	MaxRetries               int
+	CircuitBreakerThreshold  int
+	CircuitBreakerCooldownMs int
+
+	// AgreementResolver coverage cache
+	CoverageCacheTtlMs    int
+	CoverageCacheCapacity int
+
+	// TransportName selects the Transport implementation NewClient builds,
+	// looked up in the registry populated by RegisterTransport (see
+	// client.go). Defaults to "http" when empty.
+	TransportName string
+
+	// StreamMode selects how AvailabilityClient.Search receives chunks:
+	// "auto", "sse", "grpc-stream" or "poll". Defaults to "auto" when empty.
+	StreamMode string
 }
 
 // Config holds SDK configuration
 type Config struct {
 	grpc bool
 	data ConfigData
+
+	// Hot-reload support. Only populated when the Config was built by
+	// LoadConfig; a Config built by ForRest/ForGrpc or LoadConfigFromEnv
+	// simply has a no-op OnChange.
+	mu        sync.RWMutex
+	viper     *viper.Viper
+	watching  bool
+	onChanges []func(*Config)
 }
 
 // ForRest creates a new Config for REST transport
@@ -73,6 +104,18 @@ func ForGrpc(data ConfigData) *Config {
 	}
 }
 
+// ConfigForRest is an alias for ForRest, matching the naming used by the
+// gRPC-side ConfigForGrpc constructor.
+func ConfigForRest(data ConfigData) *Config {
+	return ForRest(data)
+}
+
+// ConfigForGrpc creates a new Config for gRPC transport, mirroring
+// ConfigForRest.
+func ConfigForGrpc(data ConfigData) *Config {
+	return ForGrpc(data)
+}
+
 // IsGrpc returns true if this config is for gRPC transport
 func (c *Config) IsGrpc() bool {
 	return c.grpc
@@ -105,6 +148,22 @@ func (c *Config) Get(key string) interface{} {
 		return c.data.ClientCert
 	case "clientKey":
 		return c.data.ClientKey
+	case "idempotencyTtlMs":
+		return c.data.IdempotencyTtlMs
+	case "maxRetries":
+		return c.data.MaxRetries
+	case "circuitBreakerThreshold":
+		return c.data.CircuitBreakerThreshold
+	case "circuitBreakerCooldownMs":
+		return c.data.CircuitBreakerCooldownMs
+	case "coverageCacheTtlMs":
+		return c.data.CoverageCacheTtlMs
+	case "coverageCacheCapacity":
+		return c.data.CoverageCacheCapacity
+	case "transportName":
+		return c.data.TransportName
+	case "streamMode":
+		return c.data.StreamMode
 	default:
 		return nil
 	}
@@ -121,7 +180,11 @@ func (c *Config) GetString(key string, defaultValue string) string {
 	return defaultValue
 }
 
-// GetInt retrieves an int configuration value
+// GetInt retrieves an int configuration value, treating a zero or negative
+// value as "unset" and substituting defaultValue. Fine for knobs like
+// CallTimeoutMs where 0 isn't a meaningful setting on its own; for knobs
+// where an explicit 0 means something (e.g. "disable retries"), use
+// GetIntOrZero instead.
 func (c *Config) GetInt(key string, defaultValue int) int {
 	val := c.Get(key)
 	if i, ok := val.(int); ok {
@@ -132,6 +195,18 @@ func (c *Config) GetInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// GetIntOrZero retrieves an int configuration value as-is, returning it even
+// when zero rather than substituting defaultValue - unlike GetInt, which
+// treats zero as "unset". defaultValue is only used when the key doesn't
+// resolve to an int at all (an unknown key).
+func (c *Config) GetIntOrZero(key string, defaultValue int) int {
+	val := c.Get(key)
+	if i, ok := val.(int); ok {
+		return i
+	}
+	return defaultValue
+}
+
 // WithCorrelationId creates a new Config with updated correlation ID
 func (c *Config) WithCorrelationId(id string) *Config {
 	newData := c.data
@@ -142,6 +217,42 @@ func (c *Config) WithCorrelationId(id string) *Config {
 	}
 }
 
+// OnChange registers fn to be called with the reloaded Config whenever the
+// backing config file changes on disk. Only Config values returned by
+// LoadConfig observe file changes; Config values built with ForRest/ForGrpc
+// or LoadConfigFromEnv have nothing to watch and OnChange is a no-op.
+func (c *Config) OnChange(fn func(*Config)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onChanges = append(c.onChanges, fn)
+
+	if c.viper == nil || c.watching {
+		return
+	}
+	c.watching = true
+
+	c.viper.OnConfigChange(func(_ fsnotify.Event) {
+		reloaded, err := configFromViper(c.viper)
+		if err != nil {
+			// Keep serving the last-known-good config rather than
+			// tearing down a running agent over a bad edit.
+			return
+		}
+
+		c.mu.Lock()
+		c.grpc = reloaded.grpc
+		c.data = reloaded.data
+		callbacks := append([]func(*Config){}, c.onChanges...)
+		c.mu.Unlock()
+
+		for _, cb := range callbacks {
+			cb(c)
+		}
+	})
+	c.viper.WatchConfig()
+}
+
 // generateCorrelationID generates a unique correlation ID
 func generateCorrelationID(prefix string) string {
 	rand.Seed(time.Now().UnixNano())
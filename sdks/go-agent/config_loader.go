@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// envPrefix is the prefix viper requires for automatic env overrides, e.g.
+// GLORIA_REST_BASE_URL, GLORIA_GRPC_HOST.
+const envPrefix = "GLORIA"
+
+// LoadConfig reads a config.yaml (or .json/.toml - anything viper supports)
+// at path, with sections:
+//
+//	transport: rest|grpc
+//	rest: {base_url, token, api_key}
+//	grpc: {host, ca_cert, client_cert, client_key}
+//	agent_id: ...
+//	timeouts: {call_ms, availability_sla_ms, long_poll_wait_ms}
+//
+// Any value can be overridden by an env var of the form GLORIA_<SECTION>_<KEY>
+// (e.g. GLORIA_REST_BASE_URL, GLORIA_GRPC_HOST). The returned Config supports
+// OnChange for hot-reload.
+func LoadConfig(path string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	cfg, err := configFromViper(v)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.viper = v
+	return cfg, nil
+}
+
+// LoadConfigFromEnv builds a Config purely from GLORIA_* environment
+// variables, with no backing file (and therefore no OnChange hot-reload).
+// Useful for container deployments that inject config via env only.
+func LoadConfigFromEnv() (*Config, error) {
+	v := viper.New()
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	return configFromViper(v)
+}
+
+// configFromViper builds a validated Config from whatever v currently
+// resolves - config file values overridden by GLORIA_* env vars.
+func configFromViper(v *viper.Viper) (*Config, error) {
+	transport := strings.ToLower(strings.TrimSpace(v.GetString("transport")))
+
+	data := ConfigData{
+		BaseURL:           v.GetString("rest.base_url"),
+		Token:             v.GetString("rest.token"),
+		APIKey:            v.GetString("rest.api_key"),
+		AgentID:           v.GetString("agent_id"),
+		CallTimeoutMs:     v.GetInt("timeouts.call_ms"),
+		AvailabilitySlaMs: v.GetInt("timeouts.availability_sla_ms"),
+		LongPollWaitMs:    v.GetInt("timeouts.long_poll_wait_ms"),
+		Host:              v.GetString("grpc.host"),
+		CACert:            v.GetString("grpc.ca_cert"),
+		ClientCert:        v.GetString("grpc.client_cert"),
+		ClientKey:         v.GetString("grpc.client_key"),
+	}
+
+	restConfigured := data.BaseURL != ""
+	grpcConfigured := data.Host != ""
+	if restConfigured && grpcConfigured {
+		return nil, fmt.Errorf("config specifies both rest.base_url and grpc.host; exactly one transport block is allowed")
+	}
+
+	if transport == "" {
+		// Infer from whichever block is populated when not stated explicitly.
+		if grpcConfigured && !restConfigured {
+			transport = "grpc"
+		} else {
+			transport = "rest"
+		}
+	}
+
+	switch transport {
+	case "grpc":
+		if !grpcConfigured {
+			return nil, fmt.Errorf("transport: grpc requires grpc.host to be set")
+		}
+		return ForGrpc(data), nil
+	case "rest":
+		if !restConfigured {
+			return nil, fmt.Errorf("transport: rest requires rest.base_url to be set")
+		}
+		return ForRest(data), nil
+	default:
+		return nil, fmt.Errorf("unknown transport %q: must be \"rest\" or \"grpc\"", transport)
+	}
+}
@@ -0,0 +1,188 @@
+package sdk
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// coverageCacheEntry is one cached value (an agreement ID or a LOCODE list)
+// together with the deadline past which it's considered stale.
+type coverageCacheEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// lruTTLCache is a small in-memory LRU cache with a per-entry TTL. It backs
+// AgreementResolver's agreementRef->agreementId and agreementId->coverage
+// lookups so repeated IsSupported calls don't each round-trip to the backend.
+type lruTTLCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+// newLruTTLCache creates a new lruTTLCache. capacity <= 0 means unbounded.
+func newLruTTLCache(capacity int, ttl time.Duration) *lruTTLCache {
+	return &lruTTLCache{
+		capacity: capacity,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// get returns the cached value for key, evicting it first if its TTL has
+// elapsed.
+func (c *lruTTLCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*coverageCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+// put caches value for key, refreshing its TTL and evicting the
+// least-recently-used entry if the cache is over capacity.
+func (c *lruTTLCache) put(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*coverageCacheEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	el := c.ll.PushFront(&coverageCacheEntry{key: key, value: value, expiresAt: expiresAt})
+	c.items[key] = el
+
+	if c.capacity > 0 && c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.items, oldest.Value.(*coverageCacheEntry).key)
+		}
+	}
+}
+
+// AgreementResolver resolves an agreementRef to the backend's internal
+// agreementId and caches each agreement's supported LOCODEs, so
+// LocationsClient can answer coverage questions without a round trip on
+// every call.
+type AgreementResolver struct {
+	transport Transport
+	cache     *lruTTLCache
+}
+
+// NewAgreementResolver creates a new AgreementResolver.
+func NewAgreementResolver(transport Transport, config *Config) *AgreementResolver {
+	capacity := config.GetInt("coverageCacheCapacity", 256)
+	ttlMs := config.GetInt("coverageCacheTtlMs", 600000)
+
+	return &AgreementResolver{
+		transport: transport,
+		cache:     newLruTTLCache(capacity, time.Duration(ttlMs)*time.Millisecond),
+	}
+}
+
+// agreementIDCacheKey and coverageCacheKeyFor namespace the two kinds of
+// entry sharing one cache, so an agreementRef can never collide with an
+// agreementId.
+func agreementIDCacheKey(agreementRef string) string { return "id:" + agreementRef }
+func coverageCacheKeyFor(agreementID string) string  { return "coverage:" + agreementID }
+
+// ResolveAgreementID returns agreementRef's backend agreementId, resolving
+// and caching it on first use.
+func (ar *AgreementResolver) ResolveAgreementID(ctx context.Context, agreementRef string) (string, error) {
+	key := agreementIDCacheKey(agreementRef)
+	if cached, ok := ar.cache.get(key); ok {
+		return cached.(string), nil
+	}
+
+	id, err := ar.transport.ResolveAgreement(ctx, agreementRef)
+	if err != nil {
+		return "", err
+	}
+
+	ar.cache.put(key, id)
+	return id, nil
+}
+
+// Coverage returns the LOCODEs agreementRef's agreement supports, resolving
+// the agreementId and fetching coverage on first use.
+func (ar *AgreementResolver) Coverage(ctx context.Context, agreementRef string) ([]string, error) {
+	id, err := ar.ResolveAgreementID(ctx, agreementRef)
+	if err != nil {
+		return nil, err
+	}
+
+	key := coverageCacheKeyFor(id)
+	if cached, ok := ar.cache.get(key); ok {
+		return cached.([]string), nil
+	}
+
+	locodes, err := ar.transport.AgreementCoverage(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ar.cache.put(key, locodes)
+	return locodes, nil
+}
+
+// IsSupported reports whether locode is within agreementRef's coverage.
+func (ar *AgreementResolver) IsSupported(ctx context.Context, agreementRef, locode string) (bool, error) {
+	locodes, err := ar.Coverage(ctx, agreementRef)
+	if err != nil {
+		return false, err
+	}
+
+	for _, l := range locodes {
+		if l == locode {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// WarmCoverage pre-fetches coverage for each agreementRef, for agents that
+// know their agreement list at startup and would rather pay the
+// resolve+coverage round trips up front than on the first IsSupported call.
+// A failure to warm one agreement doesn't stop the rest; failures are
+// collected and returned together.
+func (ar *AgreementResolver) WarmCoverage(ctx context.Context, agreementRefs ...string) error {
+	var failures []string
+	for _, ref := range agreementRefs {
+		if _, err := ar.Coverage(ctx, ref); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", ref, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to warm coverage for %d agreement(s): %s", len(failures), strings.Join(failures, "; "))
+	}
+	return nil
+}
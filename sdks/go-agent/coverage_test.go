@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLruTTLCacheGetPut(t *testing.T) {
+	c := newLruTTLCache(10, time.Minute)
+
+	if _, ok := c.get("missing"); ok {
+		t.Fatal("get() on an empty cache returned ok=true")
+	}
+
+	c.put("a", "value-a")
+	got, ok := c.get("a")
+	if !ok || got != "value-a" {
+		t.Fatalf("get(%q) = %v, %v; want %q, true", "a", got, ok, "value-a")
+	}
+}
+
+func TestLruTTLCacheExpires(t *testing.T) {
+	c := newLruTTLCache(10, time.Millisecond)
+	c.put("a", "value-a")
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.get("a"); ok {
+		t.Fatal("get() returned ok=true for an entry past its TTL")
+	}
+}
+
+func TestLruTTLCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newLruTTLCache(2, time.Minute)
+
+	c.put("a", 1)
+	c.put("b", 2)
+	c.put("c", 3)
+
+	if _, ok := c.get("a"); ok {
+		t.Fatal("oldest entry was not evicted once capacity was exceeded")
+	}
+	if _, ok := c.get("b"); !ok {
+		t.Fatal("\"b\" should still be cached")
+	}
+	if _, ok := c.get("c"); !ok {
+		t.Fatal("\"c\" should still be cached")
+	}
+}
+
+func TestLruTTLCacheGetRefreshesRecency(t *testing.T) {
+	c := newLruTTLCache(2, time.Minute)
+
+	c.put("a", 1)
+	c.put("b", 2)
+	c.get("a") // touch "a" so "b" becomes the least-recently-used entry
+	c.put("c", 3)
+
+	if _, ok := c.get("b"); ok {
+		t.Fatal("\"b\" should have been evicted as the least-recently-used entry")
+	}
+	if _, ok := c.get("a"); !ok {
+		t.Fatal("\"a\" should still be cached after being touched")
+	}
+}
+
+func TestLruTTLCacheUnboundedWhenCapacityNotPositive(t *testing.T) {
+	c := newLruTTLCache(0, time.Minute)
+
+	keys := []string{"a", "b", "c", "d", "e"}
+	for i, k := range keys {
+		c.put(k, i)
+	}
+
+	if _, ok := c.get("a"); !ok {
+		t.Fatal("earliest entry was evicted despite capacity <= 0 meaning unbounded")
+	}
+}
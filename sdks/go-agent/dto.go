@@ -20,6 +20,11 @@ type AvailabilityCriteria struct {
 	RatePrefs         []string
 	ResidencyCountry  string
 	Extras            map[string]interface{}
+
+	// router, if set via WithRouter, is returned by Router() for passing to
+	// EnrichAvailabilityChunk, which annotates offers with drive-time/distance
+	// context.
+	router Router
 }
 
 // MakeAvailabilityCriteria creates a new AvailabilityCriteria with validation
@@ -174,6 +179,11 @@ type BookingCreate struct {
 	
 	// Availability context (optional - if provided, will retrieve context from availability search)
 	AvailabilityRequestID string `json:"availability_request_id,omitempty"`
+
+	// LeaseRef, if set, points at a previously created Lease (see lease.go).
+	// When present the backend consumes the held inventory/price snapshot
+	// atomically instead of re-resolving the offer from scratch.
+	LeaseRef string `json:"lease_ref,omitempty"`
 	
 	// Location details (from availability search) - OTA: PickupLocation, DropOffLocation
 	PickupUnlocode   string `json:"pickup_unlocode,omitempty"`   // PickupLocation (UN/LOCODE)
@@ -190,7 +200,12 @@ type BookingCreate struct {
 	
 	// Customer and payment information (JSON objects)
 	CustomerInfo map[string]interface{} `json:"customer_info,omitempty"` // Customer name, contact details, etc.
-	PaymentInfo  map[string]interface{} `json:"payment_info,omitempty"`  // Payment details, card info, etc.
+	PaymentInfo  map[string]interface{} `json:"payment_info,omitempty"`  // Deprecated: use Payment instead.
+
+	// Payment is the typed payment abstraction (see payment.go). When set it
+	// takes precedence over PaymentInfo in ToMap() so callers never need to
+	// hand-roll the payment_info schema or push raw PAN data through the SDK.
+	Payment *PaymentInformation `json:"-"`
 	
 	// Legacy/deprecated fields (kept for backward compatibility)
 	SupplierID string  `json:"supplier_id,omitempty"` // Note: Not required - backend resolves from agreement_ref
@@ -218,7 +233,7 @@ func BookingCreateFromOffer(data map[string]interface{}) (*BookingCreate, error)
 	if agreementRef, ok := data["agreement_ref"].(string); ok {
 		booking.AgreementRef = agreementRef
 	} else {
-		return nil, fmt.Errorf("agreement_ref required")
+		return nil, NewBookingFailure(BookingFailureOther, "agreement_ref required")
 	}
 
 	// Note: supplier_id is not required - backend resolves source_id from agreement_ref
@@ -272,6 +287,9 @@ func BookingCreateFromOffer(data map[string]interface{}) (*BookingCreate, error)
 	if val, ok := data["availability_request_id"].(string); ok {
 		booking.AvailabilityRequestID = val
 	}
+	if val, ok := data["lease_ref"].(string); ok {
+		booking.LeaseRef = val
+	}
 	if val, ok := data["pickup_unlocode"].(string); ok {
 		booking.PickupUnlocode = val
 	}
@@ -312,9 +330,9 @@ func BookingCreateFromOffer(data map[string]interface{}) (*BookingCreate, error)
 	// Store extras (unknown fields)
 	for k, v := range data {
 		switch k {
-		case "agreement_ref", "supplier_id", "offer_id", "supplier_offer_ref", "agent_booking_ref", 
-		     "driver", "availability_request_id", "pickup_unlocode", "dropoff_unlocode", 
-		     "pickup_iso", "dropoff_iso", "vehicle_class", "vehicle_make_model", 
+		case "agreement_ref", "supplier_id", "offer_id", "supplier_offer_ref", "agent_booking_ref",
+		     "driver", "availability_request_id", "lease_ref", "pickup_unlocode", "dropoff_unlocode",
+		     "pickup_iso", "dropoff_iso", "vehicle_class", "vehicle_make_model",
 		     "rate_plan_code", "driver_age", "residency_country", "customer_info", "payment_info":
 			// Skip known fields
 		default:
@@ -341,6 +359,9 @@ func (bc *BookingCreate) ToMap() map[string]interface{} {
 	if bc.AvailabilityRequestID != "" {
 		result["availability_request_id"] = bc.AvailabilityRequestID
 	}
+	if bc.LeaseRef != "" {
+		result["lease_ref"] = bc.LeaseRef
+	}
 	if bc.PickupUnlocode != "" {
 		result["pickup_unlocode"] = bc.PickupUnlocode
 	}
@@ -371,7 +392,9 @@ func (bc *BookingCreate) ToMap() map[string]interface{} {
 	if bc.CustomerInfo != nil {
 		result["customer_info"] = bc.CustomerInfo
 	}
-	if bc.PaymentInfo != nil {
+	if bc.Payment != nil {
+		result["payment_info"] = bc.Payment.ToMap()
+	} else if bc.PaymentInfo != nil {
 		result["payment_info"] = bc.PaymentInfo
 	}
 	
@@ -411,10 +434,24 @@ func (bc *BookingCreate) ToMap() map[string]interface{} {
 	return result
 }
 
+// BookingStatus is the lifecycle state of a booking as reported by the
+// supplier.
+type BookingStatus string
+
+const (
+	BookingStatusConfirmed BookingStatus = "CONFIRMED"
+	BookingStatusPending   BookingStatus = "PENDING"
+	BookingStatusFailed    BookingStatus = "FAILED"
+	BookingStatusCanceled  BookingStatus = "CANCELED"
+	BookingStatusNoShow    BookingStatus = "NO_SHOW"
+	BookingStatusCompleted BookingStatus = "COMPLETED"
+)
+
 // BookingResult represents the result of a booking operation
 type BookingResult struct {
 	SupplierBookingRef string                 `json:"supplier_booking_ref"`
 	Status             string                 `json:"status"`
+	StatusCode         BookingStatus          `json:"-"`
 	Raw                map[string]interface{} `json:"-"`
 }
 
@@ -429,15 +466,78 @@ func BookingResultFromMap(data map[string]interface{}) *BookingResult {
 	}
 	if status, ok := data["status"].(string); ok {
 		result.Status = status
+		result.StatusCode = BookingStatus(status)
 	}
 
 	return result
 }
 
+// ListBookingsFilter narrows a ListBookings call.
+type ListBookingsFilter struct {
+	AgreementRef string
+	Status       BookingStatus
+	Since        time.Time
+	PageSize     int
+	Cursor       int
+}
+
+// ToMap converts ListBookingsFilter to query parameters.
+func (f *ListBookingsFilter) ToMap() map[string]interface{} {
+	result := map[string]interface{}{}
+	if f.AgreementRef != "" {
+		result["agreement_ref"] = f.AgreementRef
+	}
+	if f.Status != "" {
+		result["status"] = string(f.Status)
+	}
+	if !f.Since.IsZero() {
+		result["since"] = f.Since.Format(time.RFC3339)
+	}
+	if f.PageSize > 0 {
+		result["page_size"] = f.PageSize
+	}
+	if f.Cursor > 0 {
+		result["cursor"] = f.Cursor
+	}
+	return result
+}
+
+// BookingListPage is a single page of ListBookings results. It mirrors the
+// cursor-based pagination used by AvailabilityChunk.
+type BookingListPage struct {
+	Items  []*BookingResult       `json:"items"`
+	Cursor *int                   `json:"cursor,omitempty"`
+	Raw    map[string]interface{} `json:"-"`
+}
+
+// BookingListPageFromMap creates a BookingListPage from a map.
+func BookingListPageFromMap(data map[string]interface{}) *BookingListPage {
+	page := &BookingListPage{
+		Raw: data,
+	}
+
+	if items, ok := data["items"].([]interface{}); ok {
+		for _, raw := range items {
+			if itemMap, ok := raw.(map[string]interface{}); ok {
+				page.Items = append(page.Items, BookingResultFromMap(itemMap))
+			}
+		}
+	}
+
+	if cursor, ok := data["cursor"].(float64); ok {
+		cursorInt := int(cursor)
+		page.Cursor = &cursorInt
+	}
+
+	return page
+}
+
 // Location represents a location
 type Location struct {
 	Locode string                 `json:"locode"`
 	Name   string                 `json:"name"`
+	Lat    float64                `json:"lat,omitempty"`
+	Lon    float64                `json:"lon,omitempty"`
 	Raw    map[string]interface{} `json:"-"`
 }
 
@@ -453,6 +553,12 @@ func LocationFromMap(data map[string]interface{}) *Location {
 	if name, ok := data["name"].(string); ok {
 		loc.Name = name
 	}
+	if lat, ok := data["lat"].(float64); ok {
+		loc.Lat = lat
+	}
+	if lon, ok := data["lon"].(float64); ok {
+		loc.Lon = lon
+	}
 
 	return loc
 }
@@ -3,13 +3,28 @@ package sdk
 import (
 	"fmt"
 	"net/http"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// ErrStreamUnsupported is returned by Transport.StreamAvailability when the
+// backend has no streaming endpoint (REST: 404/406 on /availability/stream).
+// Callers in "auto" stream mode treat this as a signal to fall back to
+// long-poll instead of surfacing an error.
+var ErrStreamUnsupported = fmt.Errorf("streaming not supported by this transport")
+
 // TransportException represents an error from the transport layer
 type TransportException struct {
 	Message    string
 	StatusCode int
 	Code       string
+	// Details carries any well-known gRPC error detail messages (e.g.
+	// RetryInfo, ErrorInfo, BadRequest) attached to the originating
+	// status.Status, so a caller can react to e.g. a retry hint
+	// programmatically instead of parsing Message. Always empty for
+	// REST-sourced errors.
+	Details []proto.Message
 }
 
 // Error implements the error interface
@@ -36,16 +51,35 @@ func TransportExceptionFromHttp(err error, response *http.Response) *TransportEx
 	}
 }
 
-// FromGrpc creates a TransportException from a gRPC error
+// FromGrpc creates a TransportException from a gRPC error, unwrapping the
+// incoming *status.Status (when err carries one) to populate Code from
+// codes.Code.String() and Details from any well-known error detail messages
+// the server attached. grpcCode is used as a fallback Code only when err
+// isn't a status error at all.
 func TransportExceptionFromGrpc(err error, grpcCode interface{}) *TransportException {
-	code := ""
-	if grpcCode != nil {
-		code = fmt.Sprintf("%v", grpcCode)
+	st, ok := status.FromError(err)
+	if !ok {
+		code := ""
+		if grpcCode != nil {
+			code = fmt.Sprintf("%v", grpcCode)
+		}
+		return &TransportException{
+			Message: err.Error(),
+			Code:    code,
+		}
 	}
+
+	var details []proto.Message
+	for _, d := range st.Details() {
+		if msg, ok := d.(proto.Message); ok {
+			details = append(details, msg)
+		}
+	}
+
 	return &TransportException{
-		Message:    err.Error(),
-		StatusCode: 0,
-		Code:       code,
+		Message: st.Message(),
+		Code:    st.Code().String(),
+		Details: details,
 	}
 }
 
@@ -58,3 +92,20 @@ func NewTransportException(message string, statusCode int, code string) *Transpo
 	}
 }
 
+// CircuitOpenException is returned in place of a real call when a host's
+// circuit breaker has tripped after repeated failures, so callers fail fast
+// instead of piling more requests onto a backend that's already down.
+type CircuitOpenException struct {
+	Host string
+}
+
+// Error implements the error interface
+func (e *CircuitOpenException) Error() string {
+	return fmt.Sprintf("CircuitOpenException: circuit open for host %s", e.Host)
+}
+
+// NewCircuitOpenException creates a new CircuitOpenException
+func NewCircuitOpenException(host string) *CircuitOpenException {
+	return &CircuitOpenException{Host: host}
+}
+
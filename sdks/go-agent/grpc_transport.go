@@ -2,54 +2,460 @@ package sdk
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/carhire/sdk/carhirepb"
 )
 
-// GrpcTransport implements gRPC transport (stub implementation)
-// Note: Full gRPC implementation requires proto file generation from backend protos
+// GrpcTransport implements gRPC transport. Connection setup, mTLS, and
+// per-call metadata are wired up here; RPC bodies call the generated
+// carhirepb.CarHireServiceClient stubs (see proto/carhire.proto). Methods
+// outside that proto's scope (leases, reservations, list bookings) remain
+// unimplemented until their RPCs are defined.
 type GrpcTransport struct {
 	config *Config
+	conn   *grpc.ClientConn
+	client carhirepb.CarHireServiceClient
 }
 
-// NewGrpcTransport creates a new gRPC transport
-func NewGrpcTransport(config *Config) *GrpcTransport {
+// NewGrpcTransport creates a new gRPC transport, dialing config.Host with
+// mTLS credentials built from CACert/ClientCert/ClientKey.
+func NewGrpcTransport(config *Config) (*GrpcTransport, error) {
+	host := config.GetString("host", "")
+	if host == "" {
+		return nil, fmt.Errorf("grpc transport requires a host")
+	}
+
+	tlsConfig, err := buildGrpcTLSConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build TLS config: %w", err)
+	}
+
+	conn, err := grpc.NewClient(
+		host,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithUnaryInterceptor(agentMetadataUnaryInterceptor(config)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial grpc host %s: %w", host, err)
+	}
+
 	return &GrpcTransport{
 		config: config,
+		conn:   conn,
+		client: carhirepb.NewCarHireServiceClient(conn),
+	}, nil
+}
+
+// buildGrpcTLSConfig loads the client certificate/key pair and CA bundle
+// referenced by Config into a *tls.Config for mTLS.
+func buildGrpcTLSConfig(config *Config) (*tls.Config, error) {
+	clientCert := config.GetString("clientCert", "")
+	clientKey := config.GetString("clientKey", "")
+	caCert := config.GetString("caCert", "")
+
+	tlsConfig := &tls.Config{}
+
+	if clientCert != "" && clientKey != "" {
+		cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client cert/key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if caCert != "" {
+		caBytes, err := os.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBytes) {
+			return nil, fmt.Errorf("failed to parse CA cert %s", caCert)
+		}
+		tlsConfig.RootCAs = pool
 	}
+
+	return tlsConfig, nil
+}
+
+// agentMetadataUnaryInterceptor injects X-Agent-Id and X-Correlation-Id as
+// outgoing gRPC metadata on every unary call, mirroring the headers
+// RestTransport attaches to every HTTP request.
+func agentMetadataUnaryInterceptor(config *Config) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			"x-agent-id", config.GetString("agentId", ""),
+			"x-correlation-id", config.GetString("correlationId", ""),
+		)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// callContext derives a per-call deadline from callTimeoutMs, mirroring the
+// timeout math RestTransport uses for its own requests.
+func (gt *GrpcTransport) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeoutMs := gt.config.GetInt("callTimeoutMs", 10000) + 2000
+	return context.WithTimeout(ctx, time.Duration(timeoutMs)*time.Millisecond)
 }
 
 // AvailabilitySubmit submits an availability request via gRPC
 func (gt *GrpcTransport) AvailabilitySubmit(ctx context.Context, criteria map[string]interface{}) (map[string]interface{}, error) {
-	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+	ctx, cancel := gt.callContext(ctx)
+	defer cancel()
+
+	criteriaJson, err := json.Marshal(criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal criteria: %w", err)
+	}
+
+	req := &carhirepb.AvailabilitySubmitRequest{CriteriaJson: criteriaJson}
+	if refs, ok := criteria["agreement_refs"].([]string); ok {
+		req.AgreementRefs = refs
+	}
+	if locode, ok := criteria["pickup_unlocode"].(string); ok {
+		req.PickupLocode = locode
+	}
+	if locode, ok := criteria["dropoff_unlocode"].(string); ok {
+		req.DropoffLocode = locode
+	}
+	if t, ok := criteria["pickup_iso"].(string); ok {
+		req.PickupTime = t
+	}
+	if t, ok := criteria["dropoff_iso"].(string); ok {
+		req.DropoffTime = t
+	}
+
+	resp, err := gt.client.SubmitAvailability(ctx, req)
+	if err != nil {
+		return nil, TransportExceptionFromGrpc(err, nil)
+	}
+
+	return map[string]interface{}{"request_id": resp.GetRequestId()}, nil
 }
 
 // AvailabilityPoll polls for availability results via gRPC
 func (gt *GrpcTransport) AvailabilityPoll(ctx context.Context, requestID string, sinceSeq int, waitMs int) (map[string]interface{}, error) {
+	ctx, cancel := gt.callContext(ctx)
+	defer cancel()
+
+	resp, err := gt.client.PollAvailability(ctx, &carhirepb.AvailabilityPollRequest{
+		RequestId: requestID,
+		SinceSeq:  int32(sinceSeq),
+		WaitMs:    int32(waitMs),
+	})
+	if err != nil {
+		return nil, TransportExceptionFromGrpc(err, nil)
+	}
+
+	result := map[string]interface{}{
+		"status": resp.GetStatus(),
+		// AvailabilityChunkFromMap only recognizes "cursor" as a float64 (the
+		// shape json.Unmarshal produces for a JSON number), so build it as one
+		// here too or pagination silently never advances past 0.
+		"cursor": float64(resp.GetCursor()),
+	}
+	if len(resp.GetOffersJson()) > 0 {
+		var offers interface{}
+		if err := json.Unmarshal(resp.GetOffersJson(), &offers); err == nil {
+			result["items"] = offers
+		}
+	}
+	return result, nil
+}
+
+// ResolveAgreement resolves an agreementRef to an agreementId via gRPC
+func (gt *GrpcTransport) ResolveAgreement(ctx context.Context, agreementRef string) (string, error) {
+	ctx, cancel := gt.callContext(ctx)
+	defer cancel()
+
+	resp, err := gt.client.ResolveAgreement(ctx, &carhirepb.ResolveAgreementRequest{AgreementRef: agreementRef})
+	if err != nil {
+		return "", TransportExceptionFromGrpc(err, nil)
+	}
+	return resp.GetAgreementId(), nil
+}
+
+// AgreementCoverage returns an agreement's supported LOCODEs via gRPC
+func (gt *GrpcTransport) AgreementCoverage(ctx context.Context, agreementID string) ([]string, error) {
+	ctx, cancel := gt.callContext(ctx)
+	defer cancel()
+
+	resp, err := gt.client.AgreementCoverage(ctx, &carhirepb.AgreementCoverageRequest{AgreementId: agreementID})
+	if err != nil {
+		return nil, TransportExceptionFromGrpc(err, nil)
+	}
+	return resp.GetLocodes(), nil
+}
+
+// LeaseCreate holds inventory for an offer via gRPC
+func (gt *GrpcTransport) LeaseCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	_, cancel := gt.callContext(ctx)
+	defer cancel()
 	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
 }
 
-// IsLocationSupported checks if a location is supported via gRPC
-func (gt *GrpcTransport) IsLocationSupported(ctx context.Context, agreementRef, locode string) (bool, error) {
-	return false, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+// LeaseRelease releases a previously created lease via gRPC
+func (gt *GrpcTransport) LeaseRelease(ctx context.Context, payload map[string]interface{}) error {
+	_, cancel := gt.callContext(ctx)
+	defer cancel()
+	return fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
 }
 
 // BookingCreate creates a booking via gRPC
 func (gt *GrpcTransport) BookingCreate(ctx context.Context, payload map[string]interface{}, idempotencyKey string) (map[string]interface{}, error) {
-	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+	ctx, cancel := gt.callContext(ctx)
+	defer cancel()
+
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal booking payload: %w", err)
+	}
+
+	resp, err := gt.client.CreateBooking(ctx, &carhirepb.BookingCreateRequest{
+		IdempotencyKey: idempotencyKey,
+		PayloadJson:    payloadJson,
+	})
+	if err != nil {
+		return nil, TransportExceptionFromGrpc(err, nil)
+	}
+	return bookingResultFromProto(resp)
 }
 
 // BookingModify modifies a booking via gRPC
 func (gt *GrpcTransport) BookingModify(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
-	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+	ctx, cancel := gt.callContext(ctx)
+	defer cancel()
+
+	supplierBookingRef, _ := payload["supplier_booking_ref"].(string)
+	agreementRef, _ := payload["agreement_ref"].(string)
+
+	fields := payload["fields"]
+	if fields == nil {
+		fields = make(map[string]interface{})
+	}
+	fieldsJson, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal booking fields: %w", err)
+	}
+
+	resp, err := gt.client.ModifyBooking(ctx, &carhirepb.BookingModifyRequest{
+		SupplierBookingRef: supplierBookingRef,
+		AgreementRef:       agreementRef,
+		FieldsJson:         fieldsJson,
+	})
+	if err != nil {
+		return nil, TransportExceptionFromGrpc(err, nil)
+	}
+	return bookingResultFromProto(resp)
 }
 
 // BookingCancel cancels a booking via gRPC
 func (gt *GrpcTransport) BookingCancel(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
-	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+	ctx, cancel := gt.callContext(ctx)
+	defer cancel()
+
+	supplierBookingRef, _ := payload["supplier_booking_ref"].(string)
+	agreementRef, _ := payload["agreement_ref"].(string)
+	reason, _ := payload["reason"].(string)
+
+	resp, err := gt.client.CancelBooking(ctx, &carhirepb.BookingCancelRequest{
+		SupplierBookingRef: supplierBookingRef,
+		AgreementRef:       agreementRef,
+		Reason:             reason,
+	})
+	if err != nil {
+		return nil, TransportExceptionFromGrpc(err, nil)
+	}
+	return bookingResultFromProto(resp)
 }
 
 // BookingCheck checks a booking status via gRPC
 func (gt *GrpcTransport) BookingCheck(ctx context.Context, supplierBookingRef, agreementRef, sourceID string) (map[string]interface{}, error) {
+	ctx, cancel := gt.callContext(ctx)
+	defer cancel()
+
+	resp, err := gt.client.CheckBooking(ctx, &carhirepb.BookingCheckRequest{
+		SupplierBookingRef: supplierBookingRef,
+		AgreementRef:       agreementRef,
+		SourceId:           sourceID,
+	})
+	if err != nil {
+		return nil, TransportExceptionFromGrpc(err, nil)
+	}
+	return bookingResultFromProto(resp)
+}
+
+// bookingResultFromProto decodes a BookingResultResponse's JSON-encoded
+// result into the map[string]interface{} shape BookingResultFromMap expects,
+// the same shape doRequest/doBookingRequest parse from the REST transport.
+func bookingResultFromProto(resp *carhirepb.BookingResultResponse) (map[string]interface{}, error) {
+	if len(resp.GetResultJson()) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(resp.GetResultJson(), &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal booking result: %w", err)
+	}
+	return result, nil
+}
+
+// ReservationCreate holds an offer via gRPC
+func (gt *GrpcTransport) ReservationCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	_, cancel := gt.callContext(ctx)
+	defer cancel()
 	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
 }
 
+// ReservationExtend extends a reservation via gRPC
+func (gt *GrpcTransport) ReservationExtend(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	_, cancel := gt.callContext(ctx)
+	defer cancel()
+	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+}
+
+// ReservationRelease releases a reservation via gRPC
+func (gt *GrpcTransport) ReservationRelease(ctx context.Context, reservationRef string) error {
+	_, cancel := gt.callContext(ctx)
+	defer cancel()
+	return fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+}
+
+// ReservationConfirm confirms a reservation into a booking via gRPC
+func (gt *GrpcTransport) ReservationConfirm(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	_, cancel := gt.callContext(ctx)
+	defer cancel()
+	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+}
+
+// AvailabilityEvent is one message pushed by AvailabilityStream: either a
+// decoded chunk or a terminal error, never both.
+type AvailabilityEvent struct {
+	Chunk *AvailabilityChunk
+	Err   error
+}
+
+// AvailabilityStream opens the AvailabilityStream server-streaming RPC and
+// pushes each pushed event onto the returned channel until the backend
+// closes the stream (io.EOF), ctx is cancelled, or a gRPC error arrives. The
+// channel is closed once the goroutine returns, including after an Err
+// event - callers should treat an event carrying Err as the last one.
+func (gt *GrpcTransport) AvailabilityStream(ctx context.Context, requestID string, sinceSeq int) (<-chan AvailabilityEvent, error) {
+	stream, err := gt.client.AvailabilityStream(ctx, &carhirepb.AvailabilityStreamRequest{
+		RequestId: requestID,
+		SinceSeq:  int32(sinceSeq),
+	})
+	if err != nil {
+		return nil, TransportExceptionFromGrpc(err, nil)
+	}
+
+	events := make(chan AvailabilityEvent, 1)
+	go func() {
+		defer close(events)
+		defer stream.CloseSend()
+
+		for {
+			evt, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				select {
+				case events <- AvailabilityEvent{Err: TransportExceptionFromGrpc(err, nil)}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			chunk := availabilityChunkFromEvent(evt)
+			select {
+			case events <- AvailabilityEvent{Chunk: chunk}:
+			case <-ctx.Done():
+				return
+			}
+			if chunk.Status == "COMPLETE" {
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// availabilityChunkFromEvent adapts a carhirepb.AvailabilityEvent into an
+// AvailabilityChunk via the same map-shaped path AvailabilityChunkFromMap
+// already knows how to parse, so streaming and long-poll results look
+// identical by the time AvailabilityClient sees them.
+func availabilityChunkFromEvent(evt *carhirepb.AvailabilityEvent) *AvailabilityChunk {
+	raw := map[string]interface{}{
+		"status": evt.GetStatus(),
+		// See the matching comment in AvailabilityPoll: AvailabilityChunkFromMap
+		// only recognizes a float64 cursor.
+		"cursor": float64(evt.GetCursor()),
+	}
+	if len(evt.GetOffersJson()) > 0 {
+		var offers interface{}
+		if err := json.Unmarshal(evt.GetOffersJson(), &offers); err == nil {
+			raw["items"] = offers
+		}
+	}
+	return AvailabilityChunkFromMap(raw)
+}
+
+// StreamAvailability adapts AvailabilityStream's <-chan AvailabilityEvent
+// into the <-chan map[string]interface{} shape the Transport interface
+// expects, so AvailabilityClient.streamUntilComplete can consume either
+// transport identically.
+func (gt *GrpcTransport) StreamAvailability(ctx context.Context, requestID string) (<-chan map[string]interface{}, error) {
+	events, err := gt.AvailabilityStream(ctx, requestID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make(chan map[string]interface{}, 1)
+	go func() {
+		defer close(chunks)
+		for evt := range events {
+			if evt.Err != nil {
+				select {
+				case chunks <- map[string]interface{}{streamErrorKey: evt.Err.Error()}:
+				case <-ctx.Done():
+				}
+				return
+			}
+			select {
+			case chunks <- evt.Chunk.Raw:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return chunks, nil
+}
+
+// ListBookings lists bookings via gRPC
+func (gt *GrpcTransport) ListBookings(ctx context.Context, filter map[string]interface{}) (map[string]interface{}, error) {
+	_, cancel := gt.callContext(ctx)
+	defer cancel()
+	return nil, fmt.Errorf("gRPC transport not yet implemented - requires proto file generation from backend protos")
+}
+
+// Close tears down the underlying gRPC connection.
+func (gt *GrpcTransport) Close() error {
+	if gt.conn == nil {
+		return nil
+	}
+	return gt.conn.Close()
+}
@@ -0,0 +1,372 @@
+package sdk
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/carhire/sdk/carhirepb"
+)
+
+// GrpcWebTransport speaks gRPC-Web (application/grpc-web-text: length-prefixed
+// frames, base64-encoded, trailers delivered as a final frame) over plain
+// HTTP/1.1 POSTs, so the same carhirepb contract that serves native gRPC
+// (GrpcTransport) and JSON/REST (the generated gateway in carhirepb) also
+// serves browser/JS SDKs that can't speak HTTP/2 trailers.
+type GrpcWebTransport struct {
+	config  *Config
+	client  *http.Client
+	baseURL string
+}
+
+// NewGrpcWebTransport creates a new gRPC-Web transport, reusing
+// Config.BaseURL and the same auth headers RestTransport attaches.
+func NewGrpcWebTransport(config *Config) *GrpcWebTransport {
+	timeout := config.GetInt("callTimeoutMs", 10000) + 2000
+
+	return &GrpcWebTransport{
+		config: config,
+		client: &http.Client{
+			Timeout: time.Duration(timeout) * time.Millisecond,
+		},
+		baseURL: strings.TrimSuffix(config.GetString("baseUrl", ""), "/"),
+	}
+}
+
+// headers builds the HTTP headers for a gRPC-Web call, mirroring
+// RestTransport.headers() so both transports authenticate identically
+// against the same backend.
+func (wt *GrpcWebTransport) headers() map[string]string {
+	h := map[string]string{
+		"Authorization":    wt.config.GetString("token", ""),
+		"Content-Type":     "application/grpc-web-text",
+		"Accept":           "application/grpc-web-text",
+		"X-Grpc-Web":       "1",
+		"X-Agent-Id":       wt.config.GetString("agentId", ""),
+		"X-Correlation-Id": wt.config.GetString("correlationId", ""),
+	}
+	if apiKey := wt.config.GetString("apiKey", ""); apiKey != "" {
+		h["X-API-Key"] = apiKey
+	}
+	return h
+}
+
+// grpcWebFrame builds a single length-prefixed gRPC-Web frame: a 1-byte flag
+// (0x00 for a data frame, 0x80 for the trailer frame) followed by a 4-byte
+// big-endian length and the payload.
+func grpcWebFrame(flags byte, payload []byte) []byte {
+	frame := make([]byte, 5+len(payload))
+	frame[0] = flags
+	frame[1] = byte(len(payload) >> 24)
+	frame[2] = byte(len(payload) >> 16)
+	frame[3] = byte(len(payload) >> 8)
+	frame[4] = byte(len(payload))
+	copy(frame[5:], payload)
+	return frame
+}
+
+// parseGrpcWebFrames splits a decoded gRPC-Web body into its data frame
+// payloads and the trailer metadata carried by the final (flags&0x80 != 0)
+// frame.
+func parseGrpcWebFrames(body []byte) (dataFrames [][]byte, trailers map[string]string, err error) {
+	trailers = map[string]string{}
+
+	for len(body) > 0 {
+		if len(body) < 5 {
+			return nil, nil, fmt.Errorf("grpc-web: truncated frame header")
+		}
+		flags := body[0]
+		length := int(body[1])<<24 | int(body[2])<<16 | int(body[3])<<8 | int(body[4])
+		body = body[5:]
+		if len(body) < length {
+			return nil, nil, fmt.Errorf("grpc-web: truncated frame body")
+		}
+		payload := body[:length]
+		body = body[length:]
+
+		if flags&0x80 != 0 {
+			for _, line := range strings.Split(string(payload), "\r\n") {
+				if line == "" {
+					continue
+				}
+				parts := strings.SplitN(line, ":", 2)
+				if len(parts) != 2 {
+					continue
+				}
+				trailers[strings.ToLower(strings.TrimSpace(parts[0]))] = strings.TrimSpace(parts[1])
+			}
+			continue
+		}
+
+		dataFrames = append(dataFrames, payload)
+	}
+
+	return dataFrames, trailers, nil
+}
+
+// call performs one gRPC-Web unary round trip against fullMethod (e.g.
+// "/carhire.CarHireService/SubmitAvailability"), marshaling req and
+// unmarshaling the single expected data frame into resp. Non-200 HTTP
+// responses go through TransportExceptionFromHttp; a non-OK grpc-status
+// trailer goes through TransportExceptionFromGrpc.
+//
+// req/resp are carhirepb types, not proto.Message: the carhirepb structs are
+// hand-written stand-ins for real protoc-gen-go output (no protoc toolchain
+// in this tree) and don't implement proto.Reflect/Reset/String, so the wire
+// payload is JSON over their existing json struct tags rather than the real
+// protobuf binary encoding. Swap this for proto.Marshal/proto.Unmarshal once
+// carhirepb is generated for real.
+func (wt *GrpcWebTransport) call(ctx context.Context, fullMethod string, req interface{}, resp interface{}) error {
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal grpc-web request: %w", err)
+	}
+
+	body := base64.StdEncoding.EncodeToString(grpcWebFrame(0, reqBytes))
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, wt.baseURL+fullMethod, strings.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create grpc-web request: %w", err)
+	}
+	for k, v := range wt.headers() {
+		httpReq.Header.Set(k, v)
+	}
+
+	httpResp, err := wt.client.Do(httpReq)
+	if err != nil {
+		return TransportExceptionFromHttp(err, nil)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return TransportExceptionFromHttp(err, httpResp)
+	}
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return TransportExceptionFromHttp(fmt.Errorf("HTTP %d", httpResp.StatusCode), httpResp)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(respBody)))
+	if err != nil {
+		return fmt.Errorf("failed to base64-decode grpc-web response: %w", err)
+	}
+
+	dataFrames, trailers, err := parseGrpcWebFrames(decoded)
+	if err != nil {
+		return err
+	}
+
+	grpcStatus := codes.OK
+	if code, ok := trailers["grpc-status"]; ok {
+		if n, err := strconv.Atoi(code); err == nil {
+			grpcStatus = codes.Code(n)
+		}
+	}
+	if grpcStatus != codes.OK {
+		st := status.New(grpcStatus, trailers["grpc-message"])
+		return TransportExceptionFromGrpc(st.Err(), nil)
+	}
+
+	if len(dataFrames) == 0 {
+		return nil
+	}
+	return json.Unmarshal(dataFrames[0], resp)
+}
+
+// AvailabilitySubmit submits an availability request via gRPC-Web
+func (wt *GrpcWebTransport) AvailabilitySubmit(ctx context.Context, criteria map[string]interface{}) (map[string]interface{}, error) {
+	req, err := availabilitySubmitRequestFromMap(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &carhirepb.AvailabilitySubmitResponse{}
+	if err := wt.call(ctx, "/carhire.CarHireService/SubmitAvailability", req, resp); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"request_id": resp.GetRequestId()}, nil
+}
+
+// AvailabilityPoll polls for availability results via gRPC-Web
+func (wt *GrpcWebTransport) AvailabilityPoll(ctx context.Context, requestID string, sinceSeq int, waitMs int) (map[string]interface{}, error) {
+	resp := &carhirepb.AvailabilityChunkResponse{}
+	req := &carhirepb.AvailabilityPollRequest{
+		RequestId: requestID,
+		SinceSeq:  int32(sinceSeq),
+		WaitMs:    int32(waitMs),
+	}
+	if err := wt.call(ctx, "/carhire.CarHireService/PollAvailability", req, resp); err != nil {
+		return nil, err
+	}
+	return availabilityChunkFromEvent(&carhirepb.AvailabilityEvent{
+		Status:     resp.GetStatus(),
+		Cursor:     resp.GetCursor(),
+		OffersJson: resp.GetOffersJson(),
+	}).Raw, nil
+}
+
+// StreamAvailability has no gRPC-Web RPC binding (AvailabilityStream's
+// server-streaming frames aren't worth re-implementing over grpc-web-text
+// here); callers fall back to long-polling, the same as RestTransport does
+// when the backend has no SSE endpoint.
+func (wt *GrpcWebTransport) StreamAvailability(ctx context.Context, requestID string) (<-chan map[string]interface{}, error) {
+	return nil, ErrStreamUnsupported
+}
+
+// ResolveAgreement resolves an agreementRef to an agreementId via gRPC-Web
+func (wt *GrpcWebTransport) ResolveAgreement(ctx context.Context, agreementRef string) (string, error) {
+	resp := &carhirepb.ResolveAgreementResponse{}
+	req := &carhirepb.ResolveAgreementRequest{AgreementRef: agreementRef}
+	if err := wt.call(ctx, "/carhire.CarHireService/ResolveAgreement", req, resp); err != nil {
+		return "", err
+	}
+	return resp.GetAgreementId(), nil
+}
+
+// AgreementCoverage returns an agreement's supported LOCODEs via gRPC-Web
+func (wt *GrpcWebTransport) AgreementCoverage(ctx context.Context, agreementID string) ([]string, error) {
+	resp := &carhirepb.AgreementCoverageResponse{}
+	req := &carhirepb.AgreementCoverageRequest{AgreementId: agreementID}
+	if err := wt.call(ctx, "/carhire.CarHireService/AgreementCoverage", req, resp); err != nil {
+		return nil, err
+	}
+	return resp.GetLocodes(), nil
+}
+
+// BookingCreate creates a booking via gRPC-Web
+func (wt *GrpcWebTransport) BookingCreate(ctx context.Context, payload map[string]interface{}, idempotencyKey string) (map[string]interface{}, error) {
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal booking payload: %w", err)
+	}
+
+	resp := &carhirepb.BookingResultResponse{}
+	req := &carhirepb.BookingCreateRequest{IdempotencyKey: idempotencyKey, PayloadJson: payloadJson}
+	if err := wt.call(ctx, "/carhire.CarHireService/CreateBooking", req, resp); err != nil {
+		return nil, err
+	}
+	return bookingResultFromProto(resp)
+}
+
+// BookingModify modifies a booking via gRPC-Web
+func (wt *GrpcWebTransport) BookingModify(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	supplierBookingRef, _ := payload["supplier_booking_ref"].(string)
+	agreementRef, _ := payload["agreement_ref"].(string)
+
+	fields := payload["fields"]
+	if fields == nil {
+		fields = make(map[string]interface{})
+	}
+	fieldsJson, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal booking fields: %w", err)
+	}
+
+	resp := &carhirepb.BookingResultResponse{}
+	req := &carhirepb.BookingModifyRequest{
+		SupplierBookingRef: supplierBookingRef,
+		AgreementRef:       agreementRef,
+		FieldsJson:         fieldsJson,
+	}
+	if err := wt.call(ctx, "/carhire.CarHireService/ModifyBooking", req, resp); err != nil {
+		return nil, err
+	}
+	return bookingResultFromProto(resp)
+}
+
+// BookingCancel cancels a booking via gRPC-Web
+func (wt *GrpcWebTransport) BookingCancel(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	supplierBookingRef, _ := payload["supplier_booking_ref"].(string)
+	agreementRef, _ := payload["agreement_ref"].(string)
+	reason, _ := payload["reason"].(string)
+
+	resp := &carhirepb.BookingResultResponse{}
+	req := &carhirepb.BookingCancelRequest{SupplierBookingRef: supplierBookingRef, AgreementRef: agreementRef, Reason: reason}
+	if err := wt.call(ctx, "/carhire.CarHireService/CancelBooking", req, resp); err != nil {
+		return nil, err
+	}
+	return bookingResultFromProto(resp)
+}
+
+// BookingCheck checks a booking status via gRPC-Web
+func (wt *GrpcWebTransport) BookingCheck(ctx context.Context, supplierBookingRef, agreementRef, sourceID string) (map[string]interface{}, error) {
+	resp := &carhirepb.BookingResultResponse{}
+	req := &carhirepb.BookingCheckRequest{SupplierBookingRef: supplierBookingRef, AgreementRef: agreementRef, SourceId: sourceID}
+	if err := wt.call(ctx, "/carhire.CarHireService/CheckBooking", req, resp); err != nil {
+		return nil, err
+	}
+	return bookingResultFromProto(resp)
+}
+
+// ListBookings is not yet bound to a CarHireService RPC over gRPC-Web.
+func (wt *GrpcWebTransport) ListBookings(ctx context.Context, filter map[string]interface{}) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("grpc-web transport not yet implemented - requires a ListBookings RPC on CarHireService")
+}
+
+// LeaseCreate is not yet bound to a CarHireService RPC over gRPC-Web.
+func (wt *GrpcWebTransport) LeaseCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("grpc-web transport not yet implemented - requires a LeaseCreate RPC on CarHireService")
+}
+
+// LeaseRelease is not yet bound to a CarHireService RPC over gRPC-Web.
+func (wt *GrpcWebTransport) LeaseRelease(ctx context.Context, payload map[string]interface{}) error {
+	return fmt.Errorf("grpc-web transport not yet implemented - requires a LeaseRelease RPC on CarHireService")
+}
+
+// ReservationCreate is not yet bound to a CarHireService RPC over gRPC-Web.
+func (wt *GrpcWebTransport) ReservationCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("grpc-web transport not yet implemented - requires a ReservationCreate RPC on CarHireService")
+}
+
+// ReservationExtend is not yet bound to a CarHireService RPC over gRPC-Web.
+func (wt *GrpcWebTransport) ReservationExtend(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("grpc-web transport not yet implemented - requires a ReservationExtend RPC on CarHireService")
+}
+
+// ReservationRelease is not yet bound to a CarHireService RPC over gRPC-Web.
+func (wt *GrpcWebTransport) ReservationRelease(ctx context.Context, reservationRef string) error {
+	return fmt.Errorf("grpc-web transport not yet implemented - requires a ReservationRelease RPC on CarHireService")
+}
+
+// ReservationConfirm is not yet bound to a CarHireService RPC over gRPC-Web.
+func (wt *GrpcWebTransport) ReservationConfirm(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("grpc-web transport not yet implemented - requires a ReservationConfirm RPC on CarHireService")
+}
+
+// availabilitySubmitRequestFromMap builds an AvailabilitySubmitRequest from
+// an AvailabilityCriteria.ToMap() payload, the same field-by-field lift
+// GrpcTransport.AvailabilitySubmit does.
+func availabilitySubmitRequestFromMap(criteria map[string]interface{}) (*carhirepb.AvailabilitySubmitRequest, error) {
+	criteriaJson, err := json.Marshal(criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal criteria: %w", err)
+	}
+
+	req := &carhirepb.AvailabilitySubmitRequest{CriteriaJson: criteriaJson}
+	if refs, ok := criteria["agreement_refs"].([]string); ok {
+		req.AgreementRefs = refs
+	}
+	if locode, ok := criteria["pickup_unlocode"].(string); ok {
+		req.PickupLocode = locode
+	}
+	if locode, ok := criteria["dropoff_unlocode"].(string); ok {
+		req.DropoffLocode = locode
+	}
+	if t, ok := criteria["pickup_iso"].(string); ok {
+		req.PickupTime = t
+	}
+	if t, ok := criteria["dropoff_iso"].(string); ok {
+		req.DropoffTime = t
+	}
+	return req, nil
+}
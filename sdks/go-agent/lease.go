@@ -0,0 +1,222 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// PriceBreakdownItem represents a single line item within a Price snapshot,
+// e.g. a tax, a surcharge, or the base rental charge.
+type PriceBreakdownItem struct {
+	Type        string  `json:"type"`
+	Description string  `json:"description,omitempty"`
+	Amount      float64 `json:"amount"`
+}
+
+// Price is a point-in-time price snapshot returned alongside a Lease so
+// integrators can display final pricing to the user before payment.
+type Price struct {
+	Currency  string               `json:"currency"`
+	Subtotal  float64              `json:"subtotal"`
+	Taxes     float64              `json:"taxes"`
+	Total     float64              `json:"total"`
+	Breakdown []PriceBreakdownItem `json:"breakdown,omitempty"`
+}
+
+// PriceFromMap creates a Price from a map, as returned by the backend.
+func PriceFromMap(data map[string]interface{}) *Price {
+	if data == nil {
+		return nil
+	}
+
+	price := &Price{}
+	if currency, ok := data["currency"].(string); ok {
+		price.Currency = currency
+	}
+	if subtotal, ok := data["subtotal"].(float64); ok {
+		price.Subtotal = subtotal
+	}
+	if taxes, ok := data["taxes"].(float64); ok {
+		price.Taxes = taxes
+	}
+	if total, ok := data["total"].(float64); ok {
+		price.Total = total
+	}
+
+	if items, ok := data["breakdown"].([]interface{}); ok {
+		for _, raw := range items {
+			itemMap, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			item := PriceBreakdownItem{}
+			if t, ok := itemMap["type"].(string); ok {
+				item.Type = t
+			}
+			if d, ok := itemMap["description"].(string); ok {
+				item.Description = d
+			}
+			if a, ok := itemMap["amount"].(float64); ok {
+				item.Amount = a
+			}
+			price.Breakdown = append(price.Breakdown, item)
+		}
+	}
+
+	return price
+}
+
+// LeaseCreate represents a request to lease (hold) a previously found offer
+// ahead of confirming a booking. It carries the same search-context fields as
+// BookingCreate so the backend can re-validate and lock the underlying
+// inventory atomically.
+type LeaseCreate struct {
+	AgreementRef          string `json:"agreement_ref"`
+	SupplierOfferRef      string `json:"supplier_offer_ref,omitempty"`
+	AvailabilityRequestID string `json:"availability_request_id,omitempty"`
+
+	PickupUnlocode  string `json:"pickup_unlocode,omitempty"`
+	DropoffUnlocode string `json:"dropoff_unlocode,omitempty"`
+	PickupISO       string `json:"pickup_iso,omitempty"`
+	DropoffISO      string `json:"dropoff_iso,omitempty"`
+
+	VehicleClass     string `json:"vehicle_class,omitempty"`
+	RatePlanCode     string `json:"rate_plan_code,omitempty"`
+	DriverAge        int    `json:"driver_age,omitempty"`
+	ResidencyCountry string `json:"residency_country,omitempty"`
+
+	Extras map[string]interface{} `json:"-"`
+}
+
+// ToMap converts LeaseCreate to a map for API request.
+func (lc *LeaseCreate) ToMap() map[string]interface{} {
+	result := map[string]interface{}{
+		"agreement_ref": lc.AgreementRef,
+	}
+
+	if lc.SupplierOfferRef != "" {
+		result["supplier_offer_ref"] = lc.SupplierOfferRef
+	}
+	if lc.AvailabilityRequestID != "" {
+		result["availability_request_id"] = lc.AvailabilityRequestID
+	}
+	if lc.PickupUnlocode != "" {
+		result["pickup_unlocode"] = lc.PickupUnlocode
+	}
+	if lc.DropoffUnlocode != "" {
+		result["dropoff_unlocode"] = lc.DropoffUnlocode
+	}
+	if lc.PickupISO != "" {
+		result["pickup_iso"] = lc.PickupISO
+	}
+	if lc.DropoffISO != "" {
+		result["dropoff_iso"] = lc.DropoffISO
+	}
+	if lc.VehicleClass != "" {
+		result["vehicle_class"] = lc.VehicleClass
+	}
+	if lc.RatePlanCode != "" {
+		result["rate_plan_code"] = lc.RatePlanCode
+	}
+	if lc.DriverAge > 0 {
+		result["driver_age"] = lc.DriverAge
+	}
+	if lc.ResidencyCountry != "" {
+		result["residency_country"] = lc.ResidencyCountry
+	}
+
+	for k, v := range lc.Extras {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Lease represents a held offer: a temporary reservation of inventory with a
+// snapshotted price, returned by CreateLease. The hold expires at ExpiresAt
+// unless consumed by a BookingCreate carrying a matching LeaseRef.
+type Lease struct {
+	LeaseRef     string                 `json:"lease_ref"`
+	AgreementRef string                 `json:"agreement_ref"`
+	ExpiresAt    string                 `json:"expires_at"`
+	Price        *Price                 `json:"price,omitempty"`
+	Raw          map[string]interface{} `json:"-"`
+}
+
+// LeaseFromMap creates a Lease from a map.
+func LeaseFromMap(data map[string]interface{}) *Lease {
+	lease := &Lease{
+		Raw: data,
+	}
+
+	if ref, ok := data["lease_ref"].(string); ok {
+		lease.LeaseRef = ref
+	}
+	if agreementRef, ok := data["agreement_ref"].(string); ok {
+		lease.AgreementRef = agreementRef
+	}
+	if expiresAt, ok := data["expires_at"].(string); ok {
+		lease.ExpiresAt = expiresAt
+	}
+	if priceData, ok := data["price"].(map[string]interface{}); ok {
+		lease.Price = PriceFromMap(priceData)
+	}
+
+	return lease
+}
+
+// LeaseReference identifies a previously created Lease so it can be released
+// or consumed by a booking.
+type LeaseReference struct {
+	LeaseRef     string `json:"lease_ref"`
+	AgreementRef string `json:"agreement_ref"`
+}
+
+// LeaseClient provides lease/hold functionality, sitting between
+// availability search and booking creation.
+type LeaseClient struct {
+	transport Transport
+	config    *Config
+}
+
+// NewLeaseClient creates a new LeaseClient.
+func NewLeaseClient(transport Transport, config *Config) *LeaseClient {
+	return &LeaseClient{
+		transport: transport,
+		config:    config,
+	}
+}
+
+// CreateLease reserves inventory for an offer and returns a price snapshot
+// plus an expiry the caller must confirm before.
+func (lc *LeaseClient) CreateLease(ctx context.Context, lease LeaseCreate) (*Lease, error) {
+	if lease.AgreementRef == "" {
+		return nil, fmt.Errorf("agreement_ref required")
+	}
+
+	payload := lease.ToMap()
+	result, err := lc.transport.LeaseCreate(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return LeaseFromMap(result), nil
+}
+
+// ReleaseLease releases a previously created lease, freeing the held
+// inventory back to the pool.
+func (lc *LeaseClient) ReleaseLease(ctx context.Context, ref LeaseReference) error {
+	if ref.LeaseRef == "" {
+		return fmt.Errorf("lease_ref required")
+	}
+	if ref.AgreementRef == "" {
+		return fmt.Errorf("agreement_ref required")
+	}
+
+	payload := map[string]interface{}{
+		"lease_ref":     ref.LeaseRef,
+		"agreement_ref": ref.AgreementRef,
+	}
+
+	return lc.transport.LeaseRelease(ctx, payload)
+}
@@ -8,18 +8,56 @@ import (
 type LocationsClient struct {
 	transport Transport
 	config    *Config
+	resolver  *AgreementResolver
 }
 
-// NewLocationsClient creates a new LocationsClient
+// NewLocationsClient creates a new LocationsClient, building its own
+// AgreementResolver (and so its own coverage cache). Callers that construct a
+// LocationsClient directly, outside of Client.Locations(), get one long as
+// they keep reusing the same *LocationsClient - the cache lives in the
+// resolver, not in this constructor call.
 func NewLocationsClient(transport Transport, config *Config) *LocationsClient {
+	return NewLocationsClientWithResolver(transport, config, NewAgreementResolver(transport, config))
+}
+
+// NewLocationsClientWithResolver creates a new LocationsClient backed by an
+// existing AgreementResolver, so its coverage cache survives across
+// construction - the shape Client.Locations() needs since it hands back a
+// fresh *LocationsClient on every call.
+func NewLocationsClientWithResolver(transport Transport, config *Config, resolver *AgreementResolver) *LocationsClient {
 	return &LocationsClient{
 		transport: transport,
 		config:    config,
+		resolver:  resolver,
 	}
 }
 
-// IsSupported checks if a location is supported for a given agreement
+// IsSupported checks if a location is supported for a given agreement. It
+// resolves agreementRef to the backend's agreementId and checks its cached
+// coverage list (see AgreementResolver) rather than asking the transport
+// directly, since the backend has no agreementRef-keyed coverage endpoint.
 func (lc *LocationsClient) IsSupported(ctx context.Context, agreementRef, locode string) (bool, error) {
-	return lc.transport.IsLocationSupported(ctx, agreementRef, locode)
+	return lc.resolver.IsSupported(ctx, agreementRef, locode)
+}
+
+// ListSupportedLocations returns every Location agreementRef's agreement
+// covers.
+func (lc *LocationsClient) ListSupportedLocations(ctx context.Context, agreementRef string) ([]Location, error) {
+	locodes, err := lc.resolver.Coverage(ctx, agreementRef)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]Location, 0, len(locodes))
+	for _, locode := range locodes {
+		locations = append(locations, Location{Locode: locode})
+	}
+	return locations, nil
 }
 
+// WarmCoverage pre-fetches coverage for each agreementRef, so agents that
+// know their agreement list at startup can pay the resolve+coverage round
+// trips once, up front, instead of on the first IsSupported call.
+func (lc *LocationsClient) WarmCoverage(ctx context.Context, agreementRefs ...string) error {
+	return lc.resolver.WarmCoverage(ctx, agreementRefs...)
+}
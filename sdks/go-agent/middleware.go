@@ -0,0 +1,212 @@
+package sdk
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RequestExecutor performs one HTTP round trip. It's the unit that
+// RequestMiddleware wraps, and what client.Do satisfies directly.
+type RequestExecutor func(req *http.Request) (*http.Response, error)
+
+// RequestMiddleware wraps a RequestExecutor, letting a caller observe or
+// modify the request/response cycle (metrics, tracing, auth refresh) without
+// reaching into RestTransport internals.
+type RequestMiddleware func(next RequestExecutor) RequestExecutor
+
+// TransportOption configures a RestTransport at construction time.
+type TransportOption func(*RestTransport)
+
+// WithMiddleware appends mw to the chain applied around every outgoing HTTP
+// request, outermost call wins (the first WithMiddleware option given to
+// NewRestTransport sees the request first and the response last).
+func WithMiddleware(mw RequestMiddleware) TransportOption {
+	return func(rt *RestTransport) {
+		rt.middleware = append(rt.middleware, mw)
+	}
+}
+
+// isRetryableStatus reports whether an HTTP status code is worth retrying -
+// the three "the backend or something in front of it had a bad moment"
+// statuses, not 4xx client errors.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryBackoff returns an exponential backoff with full jitter for the given
+// (zero-indexed) attempt: a random duration in [0, 200ms*2^attempt), capped
+// at 5s so a long callTimeoutMs can't turn into a single multi-minute sleep.
+func retryBackoff(attempt int) time.Duration {
+	const base = 200 * time.Millisecond
+	const ceiling = 5 * time.Second
+
+	max := base * time.Duration(1<<uint(attempt))
+	if max > ceiling || max <= 0 {
+		max = ceiling
+	}
+
+	rand.Seed(time.Now().UnixNano())
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// generateIdempotencyKey creates a unique Idempotency-Key for a POST/PATCH
+// the caller didn't supply one for.
+func generateIdempotencyKey() string {
+	rand.Seed(time.Now().UnixNano())
+	randomBytes := make([]byte, 8)
+	for i := range randomBytes {
+		randomBytes[i] = byte(rand.Intn(256))
+	}
+	return fmt.Sprintf("go-sdk-%x", randomBytes)
+}
+
+// idempotencyEntry is a cached outcome for one Idempotency-Key.
+type idempotencyEntry struct {
+	statusCode int
+	body       []byte
+	err        error
+	expiresAt  time.Time
+}
+
+// idempotencyCache remembers the outcome of a POST/PATCH by Idempotency-Key
+// for a TTL, so an accidental retry of the same logical request (client
+// timeout, double click, at-least-once delivery) returns the original result
+// instead of executing the mutation twice.
+type idempotencyCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]idempotencyEntry
+}
+
+// newIdempotencyCache creates a new idempotencyCache with the given TTL.
+func newIdempotencyCache(ttl time.Duration) *idempotencyCache {
+	return &idempotencyCache{
+		ttl:     ttl,
+		entries: make(map[string]idempotencyEntry),
+	}
+}
+
+// get returns the cached outcome for key, if any and not expired.
+func (c *idempotencyCache) get(key string) (idempotencyEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return idempotencyEntry{}, false
+	}
+	return entry, true
+}
+
+// put caches the outcome for key until the cache's TTL elapses.
+func (c *idempotencyCache) put(key string, statusCode int, body []byte, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = idempotencyEntry{
+		statusCode: statusCode,
+		body:       body,
+		err:        err,
+		expiresAt:  time.Now().Add(c.ttl),
+	}
+}
+
+// circuitState is the state of a circuitBreaker.
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker is a per-host closed/open/half-open breaker: it trips to
+// open after threshold consecutive failures, short-circuiting further calls
+// with a CircuitOpenException; after cooldown it lets a single half-open
+// probe through, closing again on success or reopening on failure.
+type circuitBreaker struct {
+	mu sync.Mutex
+
+	threshold int
+	cooldown  time.Duration
+
+	state       circuitState
+	failures    int
+	openedAt    time.Time
+	halfOpenTry bool
+}
+
+// newCircuitBreaker creates a new circuitBreaker.
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		state:     circuitClosed,
+	}
+}
+
+// allow reports whether a call should be attempted, transitioning open ->
+// half-open once the cooldown has elapsed.
+func (cb *circuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitClosed:
+		return true
+	case circuitOpen:
+		if time.Since(cb.openedAt) < cb.cooldown {
+			return false
+		}
+		cb.state = circuitHalfOpen
+		cb.halfOpenTry = true
+		return true
+	case circuitHalfOpen:
+		if cb.halfOpenTry {
+			return false
+		}
+		cb.halfOpenTry = true
+		return true
+	default:
+		return true
+	}
+}
+
+// recordSuccess closes the breaker and resets the failure count.
+func (cb *circuitBreaker) recordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.state = circuitClosed
+	cb.failures = 0
+	cb.halfOpenTry = false
+}
+
+// recordFailure counts a failure, tripping the breaker open once threshold
+// consecutive failures have been seen (or immediately, if the failing probe
+// was itself a half-open trial).
+func (cb *circuitBreaker) recordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == circuitHalfOpen {
+		cb.state = circuitOpen
+		cb.openedAt = time.Now()
+		cb.halfOpenTry = false
+		return
+	}
+
+	cb.failures++
+	if cb.failures >= cb.threshold {
+		cb.state = circuitOpen
+		cb.openedAt = time.Now()
+	}
+}
@@ -0,0 +1,113 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerTripsAfterThreshold(t *testing.T) {
+	cases := []struct {
+		name      string
+		threshold int
+		failures  int
+		wantOpen  bool
+	}{
+		{"below threshold stays closed", 3, 2, false},
+		{"at threshold trips open", 3, 3, true},
+		{"zero threshold trips on first failure", 0, 1, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cb := newCircuitBreaker(tc.threshold, time.Minute)
+			for i := 0; i < tc.failures; i++ {
+				if !cb.allow() {
+					t.Fatalf("allow() returned false before breaker should have tripped")
+				}
+				cb.recordFailure()
+			}
+			if got := cb.allow(); got == tc.wantOpen {
+				t.Fatalf("allow() = %v, want %v", got, !tc.wantOpen)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerRecordSuccessResetsFailures(t *testing.T) {
+	cb := newCircuitBreaker(2, time.Minute)
+	cb.allow()
+	cb.recordFailure()
+	cb.recordSuccess()
+	cb.allow()
+	cb.recordFailure()
+
+	if !cb.allow() {
+		t.Fatal("breaker tripped open after a single failure following a reset")
+	}
+}
+
+func TestCircuitBreakerHalfOpenAfterCooldown(t *testing.T) {
+	cb := newCircuitBreaker(1, time.Millisecond)
+	cb.allow()
+	cb.recordFailure()
+
+	if cb.allow() {
+		t.Fatal("allow() = true immediately after tripping, want false")
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	if !cb.allow() {
+		t.Fatal("allow() = false after cooldown elapsed, want true (half-open probe)")
+	}
+	if cb.allow() {
+		t.Fatal("allow() = true for a second concurrent half-open probe, want false")
+	}
+}
+
+func TestCircuitBreakerHalfOpenFailureReopens(t *testing.T) {
+	cb := newCircuitBreaker(1, time.Millisecond)
+	cb.allow()
+	cb.recordFailure()
+	time.Sleep(5 * time.Millisecond)
+
+	if !cb.allow() {
+		t.Fatal("expected half-open probe to be allowed")
+	}
+	cb.recordFailure()
+
+	if cb.allow() {
+		t.Fatal("allow() = true immediately after a failed half-open probe, want false")
+	}
+}
+
+func TestIdempotencyCacheGetPut(t *testing.T) {
+	c := newIdempotencyCache(time.Minute)
+
+	if _, ok := c.get("missing"); ok {
+		t.Fatal("get() on an empty cache returned ok=true")
+	}
+
+	wantErr := errors.New("boom")
+	c.put("key", 200, []byte("body"), wantErr)
+
+	entry, ok := c.get("key")
+	if !ok {
+		t.Fatal("get() after put() returned ok=false")
+	}
+	if entry.statusCode != 200 || string(entry.body) != "body" || entry.err != wantErr {
+		t.Fatalf("get() = %+v, want statusCode=200 body=body err=%v", entry, wantErr)
+	}
+}
+
+func TestIdempotencyCacheExpires(t *testing.T) {
+	c := newIdempotencyCache(time.Millisecond)
+	c.put("key", 200, nil, nil)
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.get("key"); ok {
+		t.Fatal("get() returned ok=true for an entry past its TTL")
+	}
+}
@@ -0,0 +1,120 @@
+package sdk
+
+// PaymentPricingType describes how a PaymentAmount should be applied.
+type PaymentPricingType string
+
+const (
+	PricingTypeFixedRateDefault PaymentPricingType = "FIXED_RATE_DEFAULT"
+	PricingTypeMinRate          PaymentPricingType = "MIN_RATE"
+	PricingTypeNoCharge         PaymentPricingType = "NO_CHARGE"
+)
+
+// UserPaymentOption references a payment instrument the user already vaulted
+// with Gloria, by token id, instead of carrying raw card data through the
+// SDK.
+type UserPaymentOption struct {
+	TokenID string `json:"token_id"`
+}
+
+// PaymentProcessingParameters carries an opaque token minted by a PSP
+// (Stripe, Adyen, Braintree, ...) along with display-only metadata. Gloria
+// never sees the underlying cardholder data.
+type PaymentProcessingParameters struct {
+	ProcessorToken       string `json:"processor_token"`
+	ProcessorName        string `json:"processor_name,omitempty"`
+	TokenizationProvider string `json:"tokenization_provider,omitempty"`
+	BillingPostalAddress string `json:"billing_postal_address,omitempty"`
+	LastFour             string `json:"last_four,omitempty"`
+	Brand                string `json:"brand,omitempty"`
+}
+
+// PaymentAmount is the "Price" payment variant: an explicit amount to charge
+// instead of letting the supplier apply its own rate plan pricing.
+type PaymentAmount struct {
+	Currency    string             `json:"currency"`
+	PriceMicros int64              `json:"price_micros"`
+	PricingType PaymentPricingType `json:"pricing_type"`
+}
+
+// PaymentInformation is a typed replacement for BookingCreate's untyped
+// PaymentInfo map. Exactly one of the three variants should be set; ToMap
+// serializes whichever is present under a stable "payment_info" schema.
+type PaymentInformation struct {
+	UserPaymentOption           *UserPaymentOption
+	PaymentProcessingParameters *PaymentProcessingParameters
+	Price                       *PaymentAmount
+}
+
+// ToMap converts PaymentInformation to a map for API request.
+func (pi *PaymentInformation) ToMap() map[string]interface{} {
+	if pi == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{}
+
+	if pi.UserPaymentOption != nil {
+		result["user_payment_option"] = map[string]interface{}{
+			"token_id": pi.UserPaymentOption.TokenID,
+		}
+	}
+
+	if pi.PaymentProcessingParameters != nil {
+		p := pi.PaymentProcessingParameters
+		params := map[string]interface{}{
+			"processor_token": p.ProcessorToken,
+		}
+		if p.ProcessorName != "" {
+			params["processor_name"] = p.ProcessorName
+		}
+		if p.TokenizationProvider != "" {
+			params["tokenization_provider"] = p.TokenizationProvider
+		}
+		if p.BillingPostalAddress != "" {
+			params["billing_postal_address"] = p.BillingPostalAddress
+		}
+		if p.LastFour != "" {
+			params["last_four"] = p.LastFour
+		}
+		if p.Brand != "" {
+			params["brand"] = p.Brand
+		}
+		result["payment_processing_parameters"] = params
+	}
+
+	if pi.Price != nil {
+		result["price"] = map[string]interface{}{
+			"currency":     pi.Price.Currency,
+			"price_micros": pi.Price.PriceMicros,
+			"pricing_type": string(pi.Price.PricingType),
+		}
+	}
+
+	return result
+}
+
+// WithVaultedPayment sets the booking's payment to a reference to a
+// previously vaulted instrument, identified by token id.
+func (bc *BookingCreate) WithVaultedPayment(token string) *BookingCreate {
+	bc.Payment = &PaymentInformation{
+		UserPaymentOption: &UserPaymentOption{TokenID: token},
+	}
+	return bc
+}
+
+// WithProcessorToken sets the booking's payment to an opaque PSP token plus
+// display-only card metadata, so Gloria can route the charge through the
+// processor without ever touching cardholder data.
+func (bc *BookingCreate) WithProcessorToken(processorToken, processorName, tokenizationProvider, billingPostalAddress, lastFour, brand string) *BookingCreate {
+	bc.Payment = &PaymentInformation{
+		PaymentProcessingParameters: &PaymentProcessingParameters{
+			ProcessorToken:       processorToken,
+			ProcessorName:        processorName,
+			TokenizationProvider: tokenizationProvider,
+			BillingPostalAddress: billingPostalAddress,
+			LastFour:             lastFour,
+			Brand:                brand,
+		},
+	}
+	return bc
+}
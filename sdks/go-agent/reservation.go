@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// Reservation is a held offer awaiting driver details, created by
+// ReservationClient.Hold. It expires at ExpiresAt unless extended or
+// confirmed into a booking first.
+type Reservation struct {
+	ReservationRef string                 `json:"reservation_ref"`
+	AgreementRef   string                 `json:"agreement_ref"`
+	ExpiresAt      string                 `json:"expires_at"`
+	Raw            map[string]interface{} `json:"-"`
+}
+
+// ReservationFromMap creates a Reservation from a map.
+func ReservationFromMap(data map[string]interface{}) *Reservation {
+	res := &Reservation{
+		Raw: data,
+	}
+
+	if ref, ok := data["reservation_ref"].(string); ok {
+		res.ReservationRef = ref
+	}
+	if agreementRef, ok := data["agreement_ref"].(string); ok {
+		res.AgreementRef = agreementRef
+	}
+	if expiresAt, ok := data["expires_at"].(string); ok {
+		res.ExpiresAt = expiresAt
+	}
+
+	return res
+}
+
+// ReservationClient provides a two-phase hold/confirm flow, peer to
+// BookingClient: hold price/inventory briefly after search, then confirm
+// once the driver has filled in their details - avoiding the race where a
+// single-shot BookingCreate's offer expires mid-form.
+type ReservationClient struct {
+	transport Transport
+	config    *Config
+}
+
+// NewReservationClient creates a new ReservationClient.
+func NewReservationClient(transport Transport, config *Config) *ReservationClient {
+	return &ReservationClient{
+		transport: transport,
+		config:    config,
+	}
+}
+
+// Hold reserves an offer for ttlSeconds.
+func (rc *ReservationClient) Hold(ctx context.Context, offer map[string]interface{}, ttlSeconds int) (*Reservation, error) {
+	agreementRef, ok := offer["agreement_ref"].(string)
+	if !ok || agreementRef == "" {
+		return nil, fmt.Errorf("agreement_ref required")
+	}
+
+	payload := map[string]interface{}{}
+	for k, v := range offer {
+		payload[k] = v
+	}
+	if ttlSeconds > 0 {
+		payload["ttl_seconds"] = ttlSeconds
+	}
+
+	result, err := rc.transport.ReservationCreate(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReservationFromMap(result), nil
+}
+
+// Extend pushes a reservation's expiry out by ttlSeconds.
+func (rc *ReservationClient) Extend(ctx context.Context, reservationRef string, ttlSeconds int) (*Reservation, error) {
+	if reservationRef == "" {
+		return nil, fmt.Errorf("reservation_ref required")
+	}
+
+	payload := map[string]interface{}{
+		"ttl_seconds": ttlSeconds,
+	}
+
+	result, err := rc.transport.ReservationExtend(ctx, reservationRef, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReservationFromMap(result), nil
+}
+
+// Release gives up a reservation, freeing the held inventory.
+func (rc *ReservationClient) Release(ctx context.Context, reservationRef string) error {
+	if reservationRef == "" {
+		return fmt.Errorf("reservation_ref required")
+	}
+	return rc.transport.ReservationRelease(ctx, reservationRef)
+}
+
+// Confirm turns a held reservation into a confirmed booking once driver
+// details are available.
+func (rc *ReservationClient) Confirm(ctx context.Context, reservationRef string, driverDetails map[string]interface{}) (*BookingResult, error) {
+	if reservationRef == "" {
+		return nil, fmt.Errorf("reservation_ref required")
+	}
+
+	payload := map[string]interface{}{
+		"driver": driverDetails,
+	}
+
+	result, err := rc.transport.ReservationConfirm(ctx, reservationRef, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return BookingResultFromMap(result), nil
+}
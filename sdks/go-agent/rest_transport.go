@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -9,18 +10,28 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
 // RestTransport implements REST transport
 type RestTransport struct {
-	config   *Config
-	client   *http.Client
-	baseURL  string
+	config  *Config
+	client  *http.Client
+	baseURL string
+
+	idempotency *idempotencyCache
+	middleware  []RequestMiddleware
+
+	breakersMu sync.Mutex
+	breakers   map[string]*circuitBreaker
 }
 
-// NewRestTransport creates a new REST transport
-func NewRestTransport(config *Config) *RestTransport {
+// NewRestTransport creates a new REST transport. opts are applied after the
+// built-in idempotency/retry/circuit-breaker chain is wired up, so callers
+// can layer their own middleware (metrics, tracing, auth refresh) on top via
+// WithMiddleware.
+func NewRestTransport(config *Config, opts ...TransportOption) *RestTransport {
 	baseURL := config.GetString("baseUrl", "")
 	baseURL = strings.TrimSuffix(baseURL, "/")
 
@@ -30,13 +41,38 @@ func NewRestTransport(config *Config) *RestTransport {
 		timeout = 12000
 	}
 
-	return &RestTransport{
-		config:  config,
+	idempotencyTtl := time.Duration(config.GetIntOrZero("idempotencyTtlMs", 60000)) * time.Millisecond
+
+	rt := &RestTransport{
+		config: config,
 		client: &http.Client{
 			Timeout: time.Duration(timeout) * time.Millisecond,
 		},
-		baseURL: baseURL,
+		baseURL:     baseURL,
+		idempotency: newIdempotencyCache(idempotencyTtl),
+		breakers:    make(map[string]*circuitBreaker),
+	}
+
+	for _, opt := range opts {
+		opt(rt)
+	}
+
+	return rt
+}
+
+// breakerFor returns the circuit breaker for host, creating one on first use.
+func (rt *RestTransport) breakerFor(host string) *circuitBreaker {
+	rt.breakersMu.Lock()
+	defer rt.breakersMu.Unlock()
+
+	cb, ok := rt.breakers[host]
+	if !ok {
+		threshold := rt.config.GetIntOrZero("circuitBreakerThreshold", 5)
+		cooldownMs := rt.config.GetIntOrZero("circuitBreakerCooldownMs", 30000)
+		cb = newCircuitBreaker(threshold, time.Duration(cooldownMs)*time.Millisecond)
+		rt.breakers[host] = cb
 	}
+	return cb
 }
 
 // headers builds HTTP headers for requests
@@ -60,54 +96,208 @@ func (rt *RestTransport) headers(extra map[string]string) map[string]string {
 	return h
 }
 
+// execute runs one logical HTTP call through the idempotency cache, circuit
+// breaker, retry-with-backoff, and any user-supplied middleware, and returns
+// the raw status code and body so doRequest/doBookingRequest can each
+// interpret errors their own way. A fresh *http.Request (and body reader) is
+// built for every attempt, since a consumed reader can't be replayed.
+func (rt *RestTransport) execute(ctx context.Context, method, path string, bodyBytes []byte, headers map[string]string, timeout time.Duration) (int, []byte, error) {
+	idempotencyKey := headers["Idempotency-Key"]
+	if idempotencyKey == "" && (method == http.MethodPost || method == http.MethodPatch) {
+		idempotencyKey = generateIdempotencyKey()
+		headers["Idempotency-Key"] = idempotencyKey
+	}
+
+	if idempotencyKey != "" {
+		if cached, ok := rt.idempotency.get(idempotencyKey); ok {
+			return cached.statusCode, cached.body, cached.err
+		}
+	}
+
+	reqURL := rt.baseURL + path
+	parsedURL, err := url.Parse(reqURL)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse request url: %w", err)
+	}
+	breaker := rt.breakerFor(parsedURL.Host)
+
+	exec := RequestExecutor(func(req *http.Request) (*http.Response, error) {
+		return rt.client.Do(req)
+	})
+	for i := len(rt.middleware) - 1; i >= 0; i-- {
+		exec = rt.middleware[i](exec)
+	}
+
+	maxAttempts := rt.config.GetIntOrZero("maxRetries", 2) + 1
+	deadline := time.Now().Add(timeout)
+
+	var statusCode int
+	var respBody []byte
+	var callErr error
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if !breaker.allow() {
+			callErr = NewCircuitOpenException(reqURL)
+			break
+		}
+
+		attemptTimeout := time.Until(deadline)
+		if attemptTimeout <= 0 {
+			break
+		}
+		attemptCtx, cancel := context.WithTimeout(ctx, attemptTimeout)
+
+		var bodyReader io.Reader
+		if bodyBytes != nil {
+			bodyReader = bytes.NewBuffer(bodyBytes)
+		}
+
+		req, err := http.NewRequestWithContext(attemptCtx, method, reqURL, bodyReader)
+		if err != nil {
+			cancel()
+			return 0, nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := exec(req)
+		if err != nil {
+			cancel()
+			breaker.recordFailure()
+			callErr = TransportExceptionFromHttp(err, nil)
+			statusCode, respBody = 0, nil
+		} else {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			cancel()
+			if readErr != nil {
+				breaker.recordFailure()
+				callErr = TransportExceptionFromHttp(readErr, resp)
+				statusCode, respBody = resp.StatusCode, nil
+			} else if isRetryableStatus(resp.StatusCode) {
+				breaker.recordFailure()
+				callErr = nil
+				statusCode, respBody = resp.StatusCode, body
+			} else {
+				breaker.recordSuccess()
+				statusCode, respBody, callErr = resp.StatusCode, body, nil
+				break
+			}
+		}
+
+		if attempt == maxAttempts-1 {
+			break
+		}
+		if _, ok := callErr.(*CircuitOpenException); ok {
+			break
+		}
+
+		wait := retryBackoff(attempt)
+		if !time.Now().Add(wait).Before(deadline) {
+			break
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return 0, nil, ctx.Err()
+		}
+	}
+
+	if idempotencyKey != "" && isCacheableOutcome(statusCode, callErr) {
+		rt.idempotency.put(idempotencyKey, statusCode, respBody, callErr)
+	}
+
+	return statusCode, respBody, callErr
+}
+
+// isCacheableOutcome reports whether execute's result is a terminal outcome
+// worth caching under the caller's idempotency key. A CircuitOpenException or
+// a bare transport/network error (no HTTP status at all) is transient - the
+// breaker may recover, or a retry may simply succeed - so caching either
+// would serve a stale failure for the rest of idempotencyTtlMs instead of
+// letting the caller's retry actually reach the backend.
+func isCacheableOutcome(statusCode int, callErr error) bool {
+	if _, ok := callErr.(*CircuitOpenException); ok {
+		return false
+	}
+	if callErr != nil && statusCode == 0 {
+		return false
+	}
+	return true
+}
+
 // doRequest performs an HTTP request
 func (rt *RestTransport) doRequest(ctx context.Context, method, path string, body interface{}, headers map[string]string, timeout time.Duration) (map[string]interface{}, error) {
-	var bodyReader io.Reader
+	var bodyBytes []byte
 	if body != nil {
-		bodyBytes, err := json.Marshal(body)
+		var err error
+		bodyBytes, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		bodyReader = bytes.NewBuffer(bodyBytes)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, rt.baseURL+path, bodyReader)
+	statusCode, respBody, err := rt.execute(ctx, method, path, bodyBytes, headers, timeout)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, err
 	}
 
-	for k, v := range headers {
-		req.Header.Set(k, v)
+	if statusCode < 200 || statusCode >= 300 {
+		return nil, NewTransportException(
+			fmt.Sprintf("HTTP %d: %s", statusCode, string(respBody)),
+			statusCode,
+			http.StatusText(statusCode),
+		)
 	}
 
-	// Create a client with custom timeout if specified
-	client := rt.client
-	if timeout > 0 {
-		client = &http.Client{Timeout: timeout}
+	var result map[string]interface{}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		// If it's not JSON, return as string in a map
+		return map[string]interface{}{
+			"response": string(respBody),
+		}, nil
 	}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, TransportExceptionFromHttp(err, nil)
+	return result, nil
+}
+
+// doBookingRequest is like doRequest but, on a non-2xx response, first tries
+// to parse the body as a BookingFailure (the shape returned by the booking
+// endpoints - {"cause": ..., "description": ..., ...}) before falling back
+// to a generic TransportException. Booking callers get a *BookingFailure
+// error whenever the backend told us why, instead of an opaque HTTP status.
+func (rt *RestTransport) doBookingRequest(ctx context.Context, method, path string, body interface{}, headers map[string]string, timeout time.Duration) (map[string]interface{}, error) {
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	statusCode, respBody, err := rt.execute(ctx, method, path, bodyBytes, headers, timeout)
 	if err != nil {
-		return nil, TransportExceptionFromHttp(err, resp)
+		return nil, err
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+	if statusCode < 200 || statusCode >= 300 {
+		var errBody map[string]interface{}
+		if jsonErr := json.Unmarshal(respBody, &errBody); jsonErr == nil {
+			if failure := BookingFailureFromMap(errBody); failure != nil {
+				return nil, failure
+			}
+		}
 		return nil, NewTransportException(
-			fmt.Sprintf("HTTP %d: %s", resp.StatusCode, string(respBody)),
-			resp.StatusCode,
-			resp.Status,
+			fmt.Sprintf("HTTP %d: %s", statusCode, string(respBody)),
+			statusCode,
+			http.StatusText(statusCode),
 		)
 	}
 
 	var result map[string]interface{}
 	if err := json.Unmarshal(respBody, &result); err != nil {
-		// If it's not JSON, return as string in a map
 		return map[string]interface{}{
 			"response": string(respBody),
 		}, nil
@@ -141,15 +331,174 @@ func (rt *RestTransport) AvailabilityPoll(ctx context.Context, requestID string,
 	return rt.doRequest(ctx, "GET", path, nil, rt.headers(nil), timeout)
 }
 
-// IsLocationSupported checks if a location is supported.
-// Note: Currently returns false as a safe default because the backend requires
-// agreement ID (not ref) to check coverage, and there's no direct endpoint to
-// resolve agreementRef to agreementId. Location validation is automatically
-// performed during availability submit.
-func (rt *RestTransport) IsLocationSupported(ctx context.Context, agreementRef, locode string) (bool, error) {
-	// Backend doesn't have a direct /locations/supported endpoint
-	// Return false for safety - SDK users should check locations via agreement coverage endpoint
-	return false, nil
+// StreamAvailability opens an SSE connection to /availability/stream and
+// pushes each "data:" frame, decoded as JSON, onto the returned channel.
+// Returns ErrStreamUnsupported if the server doesn't have a stream endpoint
+// (404/406), so AvailabilityClient can fall back to long-poll.
+func (rt *RestTransport) StreamAvailability(ctx context.Context, requestID string) (<-chan map[string]interface{}, error) {
+	params := url.Values{}
+	params.Set("request_id", requestID)
+	path := "/availability/stream?" + params.Encode()
+
+	headers := rt.headers(map[string]string{"Accept": "text/event-stream"})
+
+	req, err := http.NewRequestWithContext(ctx, "GET", rt.baseURL+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := rt.client.Do(req)
+	if err != nil {
+		return nil, TransportExceptionFromHttp(err, nil)
+	}
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNotAcceptable {
+		resp.Body.Close()
+		return nil, ErrStreamUnsupported
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, NewTransportException(
+			fmt.Sprintf("HTTP %d: %s", resp.StatusCode, string(respBody)),
+			resp.StatusCode,
+			resp.Status,
+		)
+	}
+
+	chunks := make(chan map[string]interface{}, 1)
+	go func() {
+		defer resp.Body.Close()
+		defer close(chunks)
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+
+			data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if data == "" {
+				continue
+			}
+
+			var frame map[string]interface{}
+			if err := json.Unmarshal([]byte(data), &frame); err != nil {
+				continue
+			}
+
+			select {
+			case chunks <- frame:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			select {
+			case chunks <- map[string]interface{}{streamErrorKey: err.Error()}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return chunks, nil
+}
+
+// ReservationCreate holds an offer for later confirmation.
+func (rt *RestTransport) ReservationCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	return rt.doBookingRequest(ctx, "POST", "/reservations", payload, rt.headers(nil), timeout)
+}
+
+// ReservationExtend pushes out a reservation's expiry.
+func (rt *RestTransport) ReservationExtend(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	path := fmt.Sprintf("/reservations/%s", reservationRef)
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	return rt.doBookingRequest(ctx, "PATCH", path, payload, rt.headers(nil), timeout)
+}
+
+// ReservationRelease releases a reservation.
+func (rt *RestTransport) ReservationRelease(ctx context.Context, reservationRef string) error {
+	path := fmt.Sprintf("/reservations/%s", reservationRef)
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	_, err := rt.doRequest(ctx, "DELETE", path, nil, rt.headers(nil), timeout)
+	return err
+}
+
+// ReservationConfirm turns a reservation into a confirmed booking.
+func (rt *RestTransport) ReservationConfirm(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	path := fmt.Sprintf("/reservations/%s/confirm", reservationRef)
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	return rt.doBookingRequest(ctx, "POST", path, payload, rt.headers(nil), timeout)
+}
+
+// ResolveAgreement resolves an agreementRef to the backend's internal
+// agreementId, the key its coverage and booking endpoints actually expect.
+func (rt *RestTransport) ResolveAgreement(ctx context.Context, agreementRef string) (string, error) {
+	params := url.Values{}
+	params.Set("ref", agreementRef)
+	path := "/agreements?" + params.Encode()
+
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	result, err := rt.doRequest(ctx, "GET", path, nil, rt.headers(nil), timeout)
+	if err != nil {
+		return "", err
+	}
+
+	id, ok := result["agreement_id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("no agreement found for ref %s", agreementRef)
+	}
+	return id, nil
+}
+
+// AgreementCoverage returns the LOCODEs agreementID's agreement supports.
+func (rt *RestTransport) AgreementCoverage(ctx context.Context, agreementID string) ([]string, error) {
+	path := fmt.Sprintf("/agreements/%s/coverage", agreementID)
+
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	result, err := rt.doRequest(ctx, "GET", path, nil, rt.headers(nil), timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, _ := result["locodes"].([]interface{})
+	locodes := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if locode, ok := v.(string); ok {
+			locodes = append(locodes, locode)
+		}
+	}
+	return locodes, nil
+}
+
+// LeaseCreate holds inventory for an offer ahead of booking confirmation.
+func (rt *RestTransport) LeaseCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	return rt.doBookingRequest(ctx, "POST", "/leases", payload, rt.headers(nil), timeout)
+}
+
+// LeaseRelease releases a previously created lease.
+func (rt *RestTransport) LeaseRelease(ctx context.Context, payload map[string]interface{}) error {
+	leaseRef, ok := payload["lease_ref"].(string)
+	if !ok {
+		return fmt.Errorf("lease_ref required")
+	}
+
+	agreementRef, _ := payload["agreement_ref"].(string)
+	params := url.Values{}
+	params.Set("agreement_ref", agreementRef)
+	path := fmt.Sprintf("/leases/%s?%s", leaseRef, params.Encode())
+
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	_, err := rt.doRequest(ctx, "DELETE", path, nil, rt.headers(nil), timeout)
+	return err
 }
 
 // BookingCreate creates a booking
@@ -160,7 +509,7 @@ func (rt *RestTransport) BookingCreate(ctx context.Context, payload map[string]i
 	}
 
 	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
-	return rt.doRequest(ctx, "POST", "/bookings", payload, headers, timeout)
+	return rt.doBookingRequest(ctx, "POST", "/bookings", payload, headers, timeout)
 }
 
 // BookingModify modifies a booking
@@ -186,7 +535,7 @@ func (rt *RestTransport) BookingModify(ctx context.Context, payload map[string]i
 	path := fmt.Sprintf("/bookings/%s?%s", supplierBookingRef, params.Encode())
 
 	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
-	return rt.doRequest(ctx, "PATCH", path, fields, rt.headers(nil), timeout)
+	return rt.doBookingRequest(ctx, "PATCH", path, fields, rt.headers(nil), timeout)
 }
 
 // BookingCancel cancels a booking
@@ -207,7 +556,7 @@ func (rt *RestTransport) BookingCancel(ctx context.Context, payload map[string]i
 	path := fmt.Sprintf("/bookings/%s/cancel?%s", supplierBookingRef, params.Encode())
 
 	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
-	return rt.doRequest(ctx, "POST", path, nil, rt.headers(nil), timeout)
+	return rt.doBookingRequest(ctx, "POST", path, nil, rt.headers(nil), timeout)
 }
 
 // BookingCheck checks a booking status
@@ -224,3 +573,15 @@ func (rt *RestTransport) BookingCheck(ctx context.Context, supplierBookingRef, a
 	return rt.doRequest(ctx, "GET", path, nil, rt.headers(nil), timeout)
 }
 
+// ListBookings lists bookings matching filter, paginated via a cursor.
+func (rt *RestTransport) ListBookings(ctx context.Context, filter map[string]interface{}) (map[string]interface{}, error) {
+	params := url.Values{}
+	for k, v := range filter {
+		params.Set(k, fmt.Sprintf("%v", v))
+	}
+	path := "/bookings?" + params.Encode()
+
+	timeout := time.Duration(rt.config.GetInt("callTimeoutMs", 10000)+2000) * time.Millisecond
+	return rt.doRequest(ctx, "GET", path, nil, rt.headers(nil), timeout)
+}
+
@@ -0,0 +1,225 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RouteInfo is the result of resolving a route between two locations.
+type RouteInfo struct {
+	DistanceMeters  float64
+	DurationSeconds float64
+	Polyline        string
+}
+
+// Router resolves drive time/distance between two locations at a given
+// departure time. Implementations back AvailabilityCriteria.WithRouter so
+// agents can annotate offers with drive-time context without wiring their
+// own routing service.
+type Router interface {
+	Route(ctx context.Context, from, to Location, when time.Time) (*RouteInfo, error)
+}
+
+// NoopRouter is the default Router: it never enriches offers. Used when no
+// router has been configured so enrichment code paths stay unconditional.
+type NoopRouter struct{}
+
+// Route always returns nil, nil.
+func (NoopRouter) Route(ctx context.Context, from, to Location, when time.Time) (*RouteInfo, error) {
+	return nil, nil
+}
+
+// ValhallaRouter implements Router against a Valhalla routing engine's HTTP
+// API (https://valhalla.github.io).
+type ValhallaRouter struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewValhallaRouter creates a Router backed by a Valhalla server at baseURL.
+func NewValhallaRouter(baseURL string) *ValhallaRouter {
+	return &ValhallaRouter{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Route calls Valhalla's /route endpoint and returns the trip summary's
+// distance and duration plus the first leg's encoded shape (polyline).
+func (vr *ValhallaRouter) Route(ctx context.Context, from, to Location, when time.Time) (*RouteInfo, error) {
+	if from.Lat == 0 && from.Lon == 0 {
+		return nil, fmt.Errorf("from location has no coordinates")
+	}
+	if to.Lat == 0 && to.Lon == 0 {
+		return nil, fmt.Errorf("to location has no coordinates")
+	}
+
+	reqBody := map[string]interface{}{
+		"locations": []map[string]interface{}{
+			{"lat": from.Lat, "lon": from.Lon},
+			{"lat": to.Lat, "lon": to.Lon},
+		},
+		"costing": "auto",
+	}
+
+	bodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal valhalla request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", vr.baseURL+"/route", bytes.NewBuffer(bodyBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create valhalla request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := vr.client.Do(req)
+	if err != nil {
+		return nil, TransportExceptionFromHttp(err, nil)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, TransportExceptionFromHttp(err, resp)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, NewTransportException(
+			fmt.Sprintf("valhalla HTTP %d: %s", resp.StatusCode, string(respBody)),
+			resp.StatusCode,
+			resp.Status,
+		)
+	}
+
+	var parsed struct {
+		Trip struct {
+			Summary struct {
+				Length float64 `json:"length"` // kilometers
+				Time   float64 `json:"time"`   // seconds
+			} `json:"summary"`
+			Legs []struct {
+				Shape string `json:"shape"`
+			} `json:"legs"`
+		} `json:"trip"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse valhalla response: %w", err)
+	}
+
+	info := &RouteInfo{
+		DistanceMeters:  parsed.Trip.Summary.Length * 1000,
+		DurationSeconds: parsed.Trip.Summary.Time,
+	}
+	if len(parsed.Trip.Legs) > 0 {
+		info.Polyline = parsed.Trip.Legs[0].Shape
+	}
+
+	return info, nil
+}
+
+// LocationResolver resolves a lat/lon pair to the nearest UN/LOCODE, so
+// callers can build AvailabilityCriteria from coordinates instead of having
+// to already know the LOCODE.
+type LocationResolver interface {
+	ResolveLocode(ctx context.Context, lat, lon float64) (string, error)
+}
+
+// MakeAvailabilityCriteriaFromCoords resolves pickup/return coordinates to
+// UN/LOCODEs via resolver and then builds an AvailabilityCriteria the same
+// way MakeAvailabilityCriteria does.
+func MakeAvailabilityCriteriaFromCoords(
+	ctx context.Context,
+	resolver LocationResolver,
+	pickupLat, pickupLon float64,
+	returnLat, returnLon float64,
+	pickupAt, returnAt time.Time,
+	driverAge int,
+	currency string,
+	agreementRefs []string,
+) (*AvailabilityCriteria, error) {
+	if resolver == nil {
+		return nil, fmt.Errorf("resolver is required")
+	}
+
+	pickupLocode, err := resolver.ResolveLocode(ctx, pickupLat, pickupLon)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve pickup locode: %w", err)
+	}
+	returnLocode, err := resolver.ResolveLocode(ctx, returnLat, returnLon)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve return locode: %w", err)
+	}
+
+	return MakeAvailabilityCriteria(pickupLocode, returnLocode, pickupAt, returnAt, driverAge, currency, agreementRefs)
+}
+
+// WithRouter attaches a Router so Search results can be enriched with
+// drive-time/distance context via EnrichAvailabilityChunk.
+func (ac *AvailabilityCriteria) WithRouter(router Router) *AvailabilityCriteria {
+	ac.router = router
+	return ac
+}
+
+// Router returns the Router attached via WithRouter, or nil if none was set.
+func (ac *AvailabilityCriteria) Router() Router {
+	return ac.router
+}
+
+// WaypointETA is the estimated arrival time at an intermediate waypoint
+// along the pickup-to-dropoff route.
+type WaypointETA struct {
+	Locode string
+	ETA    time.Time
+}
+
+// RouteEnrichedOffer pairs a raw offer (as returned in AvailabilityChunk.Items)
+// with drive-time/distance context for the pickup/dropoff pair.
+type RouteEnrichedOffer struct {
+	Offer                 interface{}
+	PickupToDropoffKm     float64
+	EstimatedDriveMinutes float64
+	WaypointETAs          []WaypointETA `json:"waypoint_etas,omitempty"`
+}
+
+// EnrichedAvailabilityChunk annotates each offer in a chunk with route
+// context, useful for one-way rentals where the pickup/dropoff pair drives
+// the price.
+type EnrichedAvailabilityChunk struct {
+	AvailabilityChunk
+	Offers []RouteEnrichedOffer
+}
+
+// EnrichAvailabilityChunk resolves the route between pickup and dropoff once
+// and annotates every offer in chunk with it. If router is nil, NoopRouter is
+// used and offers are returned unannotated.
+func EnrichAvailabilityChunk(ctx context.Context, chunk *AvailabilityChunk, router Router, pickup, dropoff Location, when time.Time) (*EnrichedAvailabilityChunk, error) {
+	if chunk == nil {
+		return nil, fmt.Errorf("chunk is required")
+	}
+	if router == nil {
+		router = NoopRouter{}
+	}
+
+	route, err := router.Route(ctx, pickup, dropoff, when)
+	if err != nil {
+		return nil, err
+	}
+
+	enriched := &EnrichedAvailabilityChunk{AvailabilityChunk: *chunk}
+	for _, item := range chunk.Items {
+		offer := RouteEnrichedOffer{Offer: item}
+		if route != nil {
+			offer.PickupToDropoffKm = route.DistanceMeters / 1000
+			offer.EstimatedDriveMinutes = route.DurationSeconds / 60
+		}
+		enriched.Offers = append(enriched.Offers, offer)
+	}
+
+	return enriched, nil
+}
@@ -0,0 +1,597 @@
+package sdk
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls how the retry TransportMiddleware classifies and
+// re-attempts a failed Transport call. Classification reads directly off
+// TransportException's StatusCode/Code fields, so the same policy applies
+// whether the failing call went out over REST, gRPC, or gRPC-Web.
+type RetryPolicy struct {
+	MaxAttempts     int
+	InitialBackoff  time.Duration
+	MaxBackoff      time.Duration
+	Jitter          bool
+	RetryableStatus map[int]bool
+	RetryableCodes  map[string]bool
+}
+
+// DefaultRetryPolicy retries the statuses/codes a well-behaved client
+// should - request timeouts, rate limiting, and backend/infra hiccups -
+// never a 4xx that means the request itself was wrong.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Jitter:         true,
+		RetryableStatus: map[int]bool{
+			408: true, 425: true, 429: true,
+			500: true, 502: true, 503: true, 504: true,
+		},
+		RetryableCodes: map[string]bool{
+			"Unavailable":       true,
+			"DeadlineExceeded":  true,
+			"ResourceExhausted": true,
+		},
+	}
+}
+
+// retryable reports whether err is worth another attempt under p.
+func (p RetryPolicy) retryable(err error) bool {
+	te, ok := err.(*TransportException)
+	if !ok {
+		return false
+	}
+	if te.StatusCode > 0 {
+		return p.RetryableStatus[te.StatusCode]
+	}
+	return p.RetryableCodes[te.Code]
+}
+
+// backoff returns the delay before the given (zero-indexed) retry attempt,
+// exponential off InitialBackoff and capped at MaxBackoff, with full jitter
+// applied when Jitter is set.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	max := p.InitialBackoff * time.Duration(int64(1)<<uint(attempt))
+	if max > p.MaxBackoff || max <= 0 {
+		max = p.MaxBackoff
+	}
+	if !p.Jitter {
+		return max
+	}
+	rand.Seed(time.Now().UnixNano())
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
+
+// retry runs fn, re-attempting per p until it succeeds, returns a
+// non-retryable error, or p.MaxAttempts is exhausted. ctx cancellation aborts
+// the wait between attempts immediately.
+func (p RetryPolicy) retry(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 0; attempt < p.MaxAttempts; attempt++ {
+		err = fn()
+		if err == nil || !p.retryable(err) || attempt == p.MaxAttempts-1 {
+			return err
+		}
+		select {
+		case <-time.After(p.backoff(attempt)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+// HedgingPolicy fans a call out to Fanout parallel attempts staggered by
+// Delay, taking whichever response comes back first and cancelling the
+// losers via ctx - useful for latency-sensitive idempotent reads against a
+// backend with a long tail.
+type HedgingPolicy struct {
+	Fanout int
+	Delay  time.Duration
+}
+
+// hedgeResult is one attempt's outcome, boxed so hedge can work across
+// Transport methods with different return types.
+type hedgeResult struct {
+	val interface{}
+	err error
+}
+
+// hedge runs attempt up to p.Fanout times, the Nth attempt starting after
+// N*p.Delay, and returns the first result whose err is nil (or, if every
+// attempt fails, the last error observed). Losing attempts are left to
+// notice ctx cancellation on their own; hedge does not wait for them.
+func (p HedgingPolicy) hedge(ctx context.Context, attempt func(ctx context.Context) hedgeResult) hedgeResult {
+	if p.Fanout <= 1 {
+		return attempt(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgeResult, p.Fanout)
+	for i := 0; i < p.Fanout; i++ {
+		delay := time.Duration(i) * p.Delay
+		go func(delay time.Duration) {
+			if delay > 0 {
+				select {
+				case <-time.After(delay):
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case results <- attempt(ctx):
+			case <-ctx.Done():
+			}
+		}(delay)
+	}
+
+	var last hedgeResult
+	for i := 0; i < p.Fanout; i++ {
+		r := <-results
+		if r.err == nil {
+			return r
+		}
+		last = r
+	}
+	return last
+}
+
+// TransportMiddleware wraps a Transport, returning a Transport that layers
+// behavior (retry, hedging, circuit breaking, ...) around every call without
+// the wrapped Transport knowing about it - the Transport-level counterpart
+// of RequestMiddleware's HTTP-level wrapping inside RestTransport.execute.
+type TransportMiddleware func(next Transport) Transport
+
+// WrapTransport applies mws around t, outermost first: the first middleware
+// given sees a call before any of the others and sees its result last, the
+// same ordering convention WithMiddleware uses for the HTTP-level chain.
+func WrapTransport(t Transport, mws ...TransportMiddleware) Transport {
+	for i := len(mws) - 1; i >= 0; i-- {
+		t = mws[i](t)
+	}
+	return t
+}
+
+// WithRetry wraps Transport calls that are safe to repeat - AvailabilityPoll,
+// BookingCheck, ResolveAgreement, AgreementCoverage, ListBookings - in p,
+// plus BookingCreate when the caller supplied an idempotencyKey. Every other
+// method (submit, modify/cancel, leases, reservations) passes straight
+// through: without an idempotency key there's no way to tell a retried
+// mutation from a duplicate one.
+func WithRetry(p RetryPolicy) TransportMiddleware {
+	return func(next Transport) Transport {
+		return &retryTransport{next: next, policy: p}
+	}
+}
+
+type retryTransport struct {
+	next   Transport
+	policy RetryPolicy
+}
+
+func (rt *retryTransport) AvailabilitySubmit(ctx context.Context, criteria map[string]interface{}) (map[string]interface{}, error) {
+	return rt.next.AvailabilitySubmit(ctx, criteria)
+}
+
+func (rt *retryTransport) AvailabilityPoll(ctx context.Context, requestID string, sinceSeq int, waitMs int) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := rt.policy.retry(ctx, func() error {
+		var err error
+		result, err = rt.next.AvailabilityPoll(ctx, requestID, sinceSeq, waitMs)
+		return err
+	})
+	return result, err
+}
+
+func (rt *retryTransport) StreamAvailability(ctx context.Context, requestID string) (<-chan map[string]interface{}, error) {
+	return rt.next.StreamAvailability(ctx, requestID)
+}
+
+func (rt *retryTransport) ResolveAgreement(ctx context.Context, agreementRef string) (string, error) {
+	var result string
+	err := rt.policy.retry(ctx, func() error {
+		var err error
+		result, err = rt.next.ResolveAgreement(ctx, agreementRef)
+		return err
+	})
+	return result, err
+}
+
+func (rt *retryTransport) AgreementCoverage(ctx context.Context, agreementID string) ([]string, error) {
+	var result []string
+	err := rt.policy.retry(ctx, func() error {
+		var err error
+		result, err = rt.next.AgreementCoverage(ctx, agreementID)
+		return err
+	})
+	return result, err
+}
+
+func (rt *retryTransport) BookingCreate(ctx context.Context, payload map[string]interface{}, idempotencyKey string) (map[string]interface{}, error) {
+	if idempotencyKey == "" {
+		return rt.next.BookingCreate(ctx, payload, idempotencyKey)
+	}
+	var result map[string]interface{}
+	err := rt.policy.retry(ctx, func() error {
+		var err error
+		result, err = rt.next.BookingCreate(ctx, payload, idempotencyKey)
+		return err
+	})
+	return result, err
+}
+
+func (rt *retryTransport) BookingModify(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return rt.next.BookingModify(ctx, payload)
+}
+
+func (rt *retryTransport) BookingCancel(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return rt.next.BookingCancel(ctx, payload)
+}
+
+func (rt *retryTransport) BookingCheck(ctx context.Context, supplierBookingRef, agreementRef, sourceID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := rt.policy.retry(ctx, func() error {
+		var err error
+		result, err = rt.next.BookingCheck(ctx, supplierBookingRef, agreementRef, sourceID)
+		return err
+	})
+	return result, err
+}
+
+func (rt *retryTransport) ListBookings(ctx context.Context, filter map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := rt.policy.retry(ctx, func() error {
+		var err error
+		result, err = rt.next.ListBookings(ctx, filter)
+		return err
+	})
+	return result, err
+}
+
+func (rt *retryTransport) LeaseCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return rt.next.LeaseCreate(ctx, payload)
+}
+
+func (rt *retryTransport) LeaseRelease(ctx context.Context, payload map[string]interface{}) error {
+	return rt.next.LeaseRelease(ctx, payload)
+}
+
+func (rt *retryTransport) ReservationCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return rt.next.ReservationCreate(ctx, payload)
+}
+
+func (rt *retryTransport) ReservationExtend(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	return rt.next.ReservationExtend(ctx, reservationRef, payload)
+}
+
+func (rt *retryTransport) ReservationRelease(ctx context.Context, reservationRef string) error {
+	return rt.next.ReservationRelease(ctx, reservationRef)
+}
+
+func (rt *retryTransport) ReservationConfirm(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	return rt.next.ReservationConfirm(ctx, reservationRef, payload)
+}
+
+// WithHedging wraps the same read methods WithRetry treats as safe to repeat
+// (AvailabilityPoll, BookingCheck, ResolveAgreement, AgreementCoverage,
+// ListBookings) in p, racing Fanout parallel attempts instead of retrying
+// serially after a failure. Compose with WithRetry via WrapTransport to get
+// both: e.g. WrapTransport(t, WithRetry(...), WithHedging(...)).
+func WithHedging(p HedgingPolicy) TransportMiddleware {
+	return func(next Transport) Transport {
+		return &hedgingTransport{next: next, policy: p}
+	}
+}
+
+type hedgingTransport struct {
+	next   Transport
+	policy HedgingPolicy
+}
+
+func (ht *hedgingTransport) AvailabilitySubmit(ctx context.Context, criteria map[string]interface{}) (map[string]interface{}, error) {
+	return ht.next.AvailabilitySubmit(ctx, criteria)
+}
+
+func (ht *hedgingTransport) AvailabilityPoll(ctx context.Context, requestID string, sinceSeq int, waitMs int) (map[string]interface{}, error) {
+	r := ht.policy.hedge(ctx, func(ctx context.Context) hedgeResult {
+		val, err := ht.next.AvailabilityPoll(ctx, requestID, sinceSeq, waitMs)
+		return hedgeResult{val: val, err: err}
+	})
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.val.(map[string]interface{}), nil
+}
+
+func (ht *hedgingTransport) StreamAvailability(ctx context.Context, requestID string) (<-chan map[string]interface{}, error) {
+	return ht.next.StreamAvailability(ctx, requestID)
+}
+
+func (ht *hedgingTransport) ResolveAgreement(ctx context.Context, agreementRef string) (string, error) {
+	r := ht.policy.hedge(ctx, func(ctx context.Context) hedgeResult {
+		val, err := ht.next.ResolveAgreement(ctx, agreementRef)
+		return hedgeResult{val: val, err: err}
+	})
+	if r.err != nil {
+		return "", r.err
+	}
+	return r.val.(string), nil
+}
+
+func (ht *hedgingTransport) AgreementCoverage(ctx context.Context, agreementID string) ([]string, error) {
+	r := ht.policy.hedge(ctx, func(ctx context.Context) hedgeResult {
+		val, err := ht.next.AgreementCoverage(ctx, agreementID)
+		return hedgeResult{val: val, err: err}
+	})
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.val.([]string), nil
+}
+
+func (ht *hedgingTransport) BookingCreate(ctx context.Context, payload map[string]interface{}, idempotencyKey string) (map[string]interface{}, error) {
+	return ht.next.BookingCreate(ctx, payload, idempotencyKey)
+}
+
+func (ht *hedgingTransport) BookingModify(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return ht.next.BookingModify(ctx, payload)
+}
+
+func (ht *hedgingTransport) BookingCancel(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return ht.next.BookingCancel(ctx, payload)
+}
+
+func (ht *hedgingTransport) BookingCheck(ctx context.Context, supplierBookingRef, agreementRef, sourceID string) (map[string]interface{}, error) {
+	r := ht.policy.hedge(ctx, func(ctx context.Context) hedgeResult {
+		val, err := ht.next.BookingCheck(ctx, supplierBookingRef, agreementRef, sourceID)
+		return hedgeResult{val: val, err: err}
+	})
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.val.(map[string]interface{}), nil
+}
+
+func (ht *hedgingTransport) ListBookings(ctx context.Context, filter map[string]interface{}) (map[string]interface{}, error) {
+	r := ht.policy.hedge(ctx, func(ctx context.Context) hedgeResult {
+		val, err := ht.next.ListBookings(ctx, filter)
+		return hedgeResult{val: val, err: err}
+	})
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.val.(map[string]interface{}), nil
+}
+
+func (ht *hedgingTransport) LeaseCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return ht.next.LeaseCreate(ctx, payload)
+}
+
+func (ht *hedgingTransport) LeaseRelease(ctx context.Context, payload map[string]interface{}) error {
+	return ht.next.LeaseRelease(ctx, payload)
+}
+
+func (ht *hedgingTransport) ReservationCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	return ht.next.ReservationCreate(ctx, payload)
+}
+
+func (ht *hedgingTransport) ReservationExtend(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	return ht.next.ReservationExtend(ctx, reservationRef, payload)
+}
+
+func (ht *hedgingTransport) ReservationRelease(ctx context.Context, reservationRef string) error {
+	return ht.next.ReservationRelease(ctx, reservationRef)
+}
+
+func (ht *hedgingTransport) ReservationConfirm(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	return ht.next.ReservationConfirm(ctx, reservationRef, payload)
+}
+
+// WithCircuitBreaker wraps every Transport call except StreamAvailability,
+// which passes straight through, with a per-method
+// closed/open/half-open breaker (see circuitBreaker in middleware.go): once
+// threshold consecutive failures trip a method's breaker, further calls to
+// it fail fast with a CircuitOpenException until cooldown elapses and a
+// single half-open probe succeeds.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) TransportMiddleware {
+	return func(next Transport) Transport {
+		return &circuitBreakerTransport{
+			next:      next,
+			threshold: threshold,
+			cooldown:  cooldown,
+			breakers:  make(map[string]*circuitBreaker),
+		}
+	}
+}
+
+type circuitBreakerTransport struct {
+	next      Transport
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+func (ct *circuitBreakerTransport) breakerFor(method string) *circuitBreaker {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	cb, ok := ct.breakers[method]
+	if !ok {
+		cb = newCircuitBreaker(ct.threshold, ct.cooldown)
+		ct.breakers[method] = cb
+	}
+	return cb
+}
+
+// guard runs fn through method's breaker, short-circuiting with a
+// CircuitOpenException when it's open.
+func (ct *circuitBreakerTransport) guard(method string, fn func() error) error {
+	cb := ct.breakerFor(method)
+	if !cb.allow() {
+		return NewCircuitOpenException(method)
+	}
+
+	err := fn()
+	if err != nil {
+		cb.recordFailure()
+	} else {
+		cb.recordSuccess()
+	}
+	return err
+}
+
+func (ct *circuitBreakerTransport) AvailabilitySubmit(ctx context.Context, criteria map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("AvailabilitySubmit", func() error {
+		var err error
+		result, err = ct.next.AvailabilitySubmit(ctx, criteria)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) AvailabilityPoll(ctx context.Context, requestID string, sinceSeq int, waitMs int) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("AvailabilityPoll", func() error {
+		var err error
+		result, err = ct.next.AvailabilityPoll(ctx, requestID, sinceSeq, waitMs)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) StreamAvailability(ctx context.Context, requestID string) (<-chan map[string]interface{}, error) {
+	return ct.next.StreamAvailability(ctx, requestID)
+}
+
+func (ct *circuitBreakerTransport) ResolveAgreement(ctx context.Context, agreementRef string) (string, error) {
+	var result string
+	err := ct.guard("ResolveAgreement", func() error {
+		var err error
+		result, err = ct.next.ResolveAgreement(ctx, agreementRef)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) AgreementCoverage(ctx context.Context, agreementID string) ([]string, error) {
+	var result []string
+	err := ct.guard("AgreementCoverage", func() error {
+		var err error
+		result, err = ct.next.AgreementCoverage(ctx, agreementID)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) BookingCreate(ctx context.Context, payload map[string]interface{}, idempotencyKey string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("BookingCreate", func() error {
+		var err error
+		result, err = ct.next.BookingCreate(ctx, payload, idempotencyKey)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) BookingModify(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("BookingModify", func() error {
+		var err error
+		result, err = ct.next.BookingModify(ctx, payload)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) BookingCancel(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("BookingCancel", func() error {
+		var err error
+		result, err = ct.next.BookingCancel(ctx, payload)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) BookingCheck(ctx context.Context, supplierBookingRef, agreementRef, sourceID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("BookingCheck", func() error {
+		var err error
+		result, err = ct.next.BookingCheck(ctx, supplierBookingRef, agreementRef, sourceID)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) ListBookings(ctx context.Context, filter map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("ListBookings", func() error {
+		var err error
+		result, err = ct.next.ListBookings(ctx, filter)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) LeaseCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("LeaseCreate", func() error {
+		var err error
+		result, err = ct.next.LeaseCreate(ctx, payload)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) LeaseRelease(ctx context.Context, payload map[string]interface{}) error {
+	return ct.guard("LeaseRelease", func() error {
+		return ct.next.LeaseRelease(ctx, payload)
+	})
+}
+
+func (ct *circuitBreakerTransport) ReservationCreate(ctx context.Context, payload map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("ReservationCreate", func() error {
+		var err error
+		result, err = ct.next.ReservationCreate(ctx, payload)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) ReservationExtend(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("ReservationExtend", func() error {
+		var err error
+		result, err = ct.next.ReservationExtend(ctx, reservationRef, payload)
+		return err
+	})
+	return result, err
+}
+
+func (ct *circuitBreakerTransport) ReservationRelease(ctx context.Context, reservationRef string) error {
+	return ct.guard("ReservationRelease", func() error {
+		return ct.next.ReservationRelease(ctx, reservationRef)
+	})
+}
+
+func (ct *circuitBreakerTransport) ReservationConfirm(ctx context.Context, reservationRef string, payload map[string]interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := ct.guard("ReservationConfirm", func() error {
+		var err error
+		result, err = ct.next.ReservationConfirm(ctx, reservationRef, payload)
+		return err
+	})
+	return result, err
+}